@@ -0,0 +1,64 @@
+package vec
+
+import "math"
+
+// Affine2 is a 2D affine transform stored as the top two rows of a 3x3
+// matrix in row-major order, so a point p maps to
+// (a[0][0]*x + a[0][1]*y + a[0][2], a[1][0]*x + a[1][1]*y + a[1][2]).
+// This is the same layout as ebiten.GeoM.
+type Affine2 [2][3]float64
+
+// IdentityAffine2 returns the identity transform.
+func IdentityAffine2() Affine2 { return Affine2{{1, 0, 0}, {0, 1, 0}} }
+
+// Translation2 returns the transform translating by t.
+func Translation2(t Vec2) Affine2 { return Affine2{{1, 0, t.X}, {0, 1, t.Y}} }
+
+// Rotation2 returns the transform rotating counter-clockwise by angle.
+func Rotation2(angle float64) Affine2 {
+	sin, cos := math.Sincos(angle)
+	return Affine2{{cos, -sin, 0}, {sin, cos, 0}}
+}
+
+// Scaling2 returns the transform scaling by s per axis.
+func Scaling2(s Vec2) Affine2 { return Affine2{{s.X, 0, 0}, {0, s.Y, 0}} }
+
+// Linear returns the linear part of a.
+func (a Affine2) Linear() Mat2 { return Mat2{{a[0][0], a[0][1]}, {a[1][0], a[1][1]}} }
+
+// Offset returns the translation part of a.
+func (a Affine2) Offset() Vec2 { return Vec2{a[0][2], a[1][2]} }
+
+// Mul returns the composition a*b, which applies b first and then a.
+func (a Affine2) Mul(b Affine2) Affine2 {
+	var r Affine2
+	for i := range 2 {
+		for j := range 3 {
+			r[i][j] = a[i][0]*b[0][j] + a[i][1]*b[1][j]
+		}
+		r[i][2] += a[i][2]
+	}
+	return r
+}
+
+// Apply returns the point p transformed by a.
+func (a Affine2) Apply(p Vec2) Vec2 {
+	return Vec2{
+		a[0][0]*p.X + a[0][1]*p.Y + a[0][2],
+		a[1][0]*p.X + a[1][1]*p.Y + a[1][2],
+	}
+}
+
+// ApplyVector returns the direction v transformed by a, ignoring translation.
+func (a Affine2) ApplyVector(v Vec2) Vec2 { return a.Linear().MulVec(v) }
+
+// Inverse returns the inverse of a.
+// Returns false if a is singular.
+func (a Affine2) Inverse() (Affine2, bool) {
+	m, ok := a.Linear().Inverse()
+	if !ok {
+		return Affine2{}, false
+	}
+	t := m.MulVec(a.Offset())
+	return Affine2{{m[0][0], m[0][1], -t.X}, {m[1][0], m[1][1], -t.Y}}, true
+}
@@ -0,0 +1,80 @@
+package vec
+
+import "math"
+
+// AlignPointSets2 returns the rotation, translation and scale that best map
+// src onto dst in the least-squares sense, so that dst[i] is approximately
+// scale*rot.MulVec(src[i]) + t. Points correspond by index and the slices
+// must have equal length. If withScale is false, the transform is rigid
+// and scale is 1. Reflections are never returned.
+func AlignPointSets2(src, dst []Vec2, withScale bool) (rot Mat2, t Vec2, scale float64) {
+	ms, md := Mean2(src), Mean2(dst)
+	var dot, cross, norm float64
+	for i := range src {
+		a, b := src[i].Sub(ms), dst[i].Sub(md)
+		dot += Dot2(a, b)
+		cross += Cross2(a, b)
+		norm += LenSq2(a)
+	}
+	sin, cos := math.Sincos(math.Atan2(cross, dot))
+	rot = Mat2{{cos, -sin}, {sin, cos}}
+	scale = 1
+	if withScale && norm > 0 {
+		scale = math.Hypot(dot, cross) / norm
+	}
+	return rot, md.Sub(rot.MulVec(ms).Scale(scale)), scale
+}
+
+// AlignPointSets3 returns the rotation, translation and scale that best map
+// src onto dst in the least-squares sense, so that dst[i] is approximately
+// scale*rot.MulVec(src[i]) + t. Points correspond by index and the slices
+// must have equal length. If withScale is false, the transform is rigid
+// and scale is 1. Reflections are never returned.
+//
+// The rotation is found with Horn's closed-form quaternion method, which is
+// equivalent to the Kabsch algorithm.
+func AlignPointSets3(src, dst []Vec3, withScale bool) (rot Mat3, t Vec3, scale float64) {
+	ms, md := Mean3(src), Mean3(dst)
+	var m Mat3 // cross-covariance, m[i][j] = Σ a_i b_j
+	var norm float64
+	for i := range src {
+		a, b := ToArray3(src[i].Sub(ms)), ToArray3(dst[i].Sub(md))
+		for r := range 3 {
+			for c := range 3 {
+				m[r][c] += a[r] * b[c]
+			}
+		}
+		norm += LenSq3(src[i].Sub(ms))
+	}
+
+	sxx, sxy, sxz := m[0][0], m[0][1], m[0][2]
+	syx, syy, syz := m[1][0], m[1][1], m[1][2]
+	szx, szy, szz := m[2][0], m[2][1], m[2][2]
+	n := [][]float64{
+		{sxx + syy + szz, syz - szy, szx - sxz, sxy - syx},
+		{0, sxx - syy - szz, sxy + syx, szx + sxz},
+		{0, 0, -sxx + syy - szz, syz + szy},
+		{0, 0, 0, -sxx - syy + szz},
+	}
+	_, vecs := jacobiEigen(n)
+	rot = quatToMat3(vecs[0][0], vecs[1][0], vecs[2][0], vecs[3][0])
+
+	scale = 1
+	if withScale && norm > 0 {
+		var num float64
+		for i := range src {
+			num += Dot3(dst[i].Sub(md), rot.MulVec(src[i].Sub(ms)))
+		}
+		scale = num / norm
+	}
+	return rot, md.Sub(rot.MulVec(ms).Scale(scale)), scale
+}
+
+// quatToMat3 returns the rotation matrix of the unit quaternion w+xi+yj+zk.
+func quatToMat3(w, x, y, z float64) Mat3 {
+	return Mat3{
+		{1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y)},
+		{2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x)},
+		{2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y)},
+	}
+}
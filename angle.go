@@ -0,0 +1,27 @@
+package vec
+
+import "math"
+
+// Radians converts an angle from degrees to radians.
+func Radians(deg float64) float64 { return deg * (math.Pi / 180) }
+
+// Degrees converts an angle from radians to degrees.
+func Degrees(rad float64) float64 { return rad * (180 / math.Pi) }
+
+// WrapAngle returns the angle equivalent to a in the range (-π, π].
+func WrapAngle(a float64) float64 {
+	r := math.Remainder(a, 2*math.Pi)
+	if r <= -math.Pi {
+		r += 2 * math.Pi
+	}
+	return r
+}
+
+// DeltaAngle returns the signed angle in (-π, π] by which to turn from a
+// to reach b the short way. It is positive for counter-clockwise turns.
+func DeltaAngle(a, b float64) float64 { return WrapAngle(b - a) }
+
+// LerpAngle interpolates between the angles a and b by t along the shorter
+// arc, so that turning from 170° to -170° passes through 180° rather than 0°.
+// The result is not wrapped; see WrapAngle.
+func LerpAngle(a, b, t float64) float64 { return a + DeltaAngle(a, b)*t }
@@ -0,0 +1,30 @@
+package vec
+
+import "math"
+
+// ArcPointAt returns the point at fraction t of the circular arc around
+// center with the given radius, starting at startAngle and turning sweep
+// radians counter-clockwise, or clockwise if sweep is negative.
+func ArcPointAt(center Vec2, radius, startAngle, sweep, t float64) Vec2 {
+	sin, cos := math.Sincos(startAngle + sweep*t)
+	return Vec2{center.X + radius*cos, center.Y + radius*sin}
+}
+
+// ArcLength returns the length of a circular arc of the given radius
+// spanning sweep radians in either direction.
+func ArcLength(radius, sweep float64) float64 { return math.Abs(radius * sweep) }
+
+// ArcSweep returns the sweep from angle start to angle end, turning
+// counter-clockwise if ccw is true and clockwise otherwise. The result is
+// in [0, 2π) for counter-clockwise turns and (-2π, 0] for clockwise ones,
+// as for the length of rope wound around a wheel between two tangents.
+func ArcSweep(start, end float64, ccw bool) float64 {
+	d := math.Mod(end-start, 2*math.Pi)
+	switch {
+	case ccw && d < 0:
+		d += 2 * math.Pi
+	case !ccw && d > 0:
+		d -= 2 * math.Pi
+	}
+	return d
+}
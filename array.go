@@ -0,0 +1,77 @@
+package vec
+
+import "unsafe"
+
+// Conversions between vectors and array types such as mgl32.Vec3, which is
+// defined as [3]float32. A Vec3g[float32] has the same memory layout, so
+// slices convert without copying:
+//
+//	m := vec.ToArrayAs3[mgl32.Vec3](v)
+//	v := vec.FromArray3(m)
+//	vs := vec.FromArrays3(meshVerts) // []mgl32.Vec3 -> []vec.Vec3g[float32]
+
+// Array returns the components of a as an array.
+func (a Vec2g[S]) Array() [2]S { return [2]S{a.X, a.Y} }
+
+// Array returns the components of a as an array.
+func (a Vec3g[S]) Array() [3]S { return [3]S{a.X, a.Y, a.Z} }
+
+// Array returns the components of a as an array.
+func (a Vec4g[S]) Array() [4]S { return [4]S{a.X, a.Y, a.Z, a.W} }
+
+// FromArray2 returns the elements of a as a vector.
+func FromArray2[A ~[2]S, S Scalar](a A) Vec2g[S] { return Vec2g[S]{a[0], a[1]} }
+
+// FromArray3 returns the elements of a as a vector.
+func FromArray3[A ~[3]S, S Scalar](a A) Vec3g[S] { return Vec3g[S]{a[0], a[1], a[2]} }
+
+// FromArray4 returns the elements of a as a vector.
+func FromArray4[A ~[4]S, S Scalar](a A) Vec4g[S] { return Vec4g[S]{a[0], a[1], a[2], a[3]} }
+
+// ToArrayAs2 returns the components of v as the array type A.
+func ToArrayAs2[A ~[2]S, V Vec2like[S], S Scalar](v V) A {
+	vv := Vec2g[S](v)
+	return A{vv.X, vv.Y}
+}
+
+// ToArrayAs3 returns the components of v as the array type A.
+func ToArrayAs3[A ~[3]S, V Vec3like[S], S Scalar](v V) A {
+	vv := Vec3g[S](v)
+	return A{vv.X, vv.Y, vv.Z}
+}
+
+// ToArrayAs4 returns the components of v as the array type A.
+func ToArrayAs4[A ~[4]S, V Vec4like[S], S Scalar](v V) A {
+	vv := Vec4g[S](v)
+	return A{vv.X, vv.Y, vv.Z, vv.W}
+}
+
+// FromArrays2 reinterprets as as a slice of vectors sharing its memory.
+func FromArrays2[A ~[2]S, S Scalar](as []A) []Vec2g[S] {
+	return unsafe.Slice((*Vec2g[S])(unsafe.Pointer(unsafe.SliceData(as))), len(as))
+}
+
+// FromArrays3 reinterprets as as a slice of vectors sharing its memory.
+func FromArrays3[A ~[3]S, S Scalar](as []A) []Vec3g[S] {
+	return unsafe.Slice((*Vec3g[S])(unsafe.Pointer(unsafe.SliceData(as))), len(as))
+}
+
+// FromArrays4 reinterprets as as a slice of vectors sharing its memory.
+func FromArrays4[A ~[4]S, S Scalar](as []A) []Vec4g[S] {
+	return unsafe.Slice((*Vec4g[S])(unsafe.Pointer(unsafe.SliceData(as))), len(as))
+}
+
+// ToArrays2 reinterprets vs as a slice of arrays sharing its memory.
+func ToArrays2[A ~[2]S, S Scalar](vs []Vec2g[S]) []A {
+	return unsafe.Slice((*A)(unsafe.Pointer(unsafe.SliceData(vs))), len(vs))
+}
+
+// ToArrays3 reinterprets vs as a slice of arrays sharing its memory.
+func ToArrays3[A ~[3]S, S Scalar](vs []Vec3g[S]) []A {
+	return unsafe.Slice((*A)(unsafe.Pointer(unsafe.SliceData(vs))), len(vs))
+}
+
+// ToArrays4 reinterprets vs as a slice of arrays sharing its memory.
+func ToArrays4[A ~[4]S, S Scalar](vs []Vec4g[S]) []A {
+	return unsafe.Slice((*A)(unsafe.Pointer(unsafe.SliceData(vs))), len(vs))
+}
@@ -0,0 +1,214 @@
+package vec
+
+// Pointer-receiver variants of the arithmetic methods update a vector in
+// place: p.AddAssign(v) is p = p.Add(v). They read naturally when updating
+// slice elements in hot loops. The value methods inline just as well, so
+// the benchmarks in assign_test.go show both forms running at the same
+// speed; pick whichever reads better.
+
+// Vec2
+// ---
+
+// AddAssign sets a += b.
+func (a *Vec2g[S]) AddAssign(b Vec2g[S]) {
+	a.X += b.X
+	a.Y += b.Y
+}
+
+// SubAssign sets a -= b.
+func (a *Vec2g[S]) SubAssign(b Vec2g[S]) {
+	a.X -= b.X
+	a.Y -= b.Y
+}
+
+// MulAssign sets a *= b component-wise.
+func (a *Vec2g[S]) MulAssign(b Vec2g[S]) {
+	a.X *= b.X
+	a.Y *= b.Y
+}
+
+// DivAssign sets a /= b component-wise.
+func (a *Vec2g[S]) DivAssign(b Vec2g[S]) {
+	a.X /= b.X
+	a.Y /= b.Y
+}
+
+// AddsAssign adds s to each component of a.
+func (a *Vec2g[S]) AddsAssign(s S) {
+	a.X += s
+	a.Y += s
+}
+
+// SubsAssign subtracts s from each component of a.
+func (a *Vec2g[S]) SubsAssign(s S) {
+	a.X -= s
+	a.Y -= s
+}
+
+// MulsAssign multiplies each component of a by s.
+func (a *Vec2g[S]) MulsAssign(s S) {
+	a.X *= s
+	a.Y *= s
+}
+
+// DivsAssign divides each component of a by s.
+func (a *Vec2g[S]) DivsAssign(s S) {
+	a.X /= s
+	a.Y /= s
+}
+
+// NegAssign negates a.
+func (a *Vec2g[S]) NegAssign() {
+	a.X = -a.X
+	a.Y = -a.Y
+}
+
+// NormalizeAssign sets a to its unit vector, or zero if it has zero length.
+func (a *Vec2g[S]) NormalizeAssign() { *a = Normalize2(*a) }
+
+// Vec3
+// ---
+
+// AddAssign sets a += b.
+func (a *Vec3g[S]) AddAssign(b Vec3g[S]) {
+	a.X += b.X
+	a.Y += b.Y
+	a.Z += b.Z
+}
+
+// SubAssign sets a -= b.
+func (a *Vec3g[S]) SubAssign(b Vec3g[S]) {
+	a.X -= b.X
+	a.Y -= b.Y
+	a.Z -= b.Z
+}
+
+// MulAssign sets a *= b component-wise.
+func (a *Vec3g[S]) MulAssign(b Vec3g[S]) {
+	a.X *= b.X
+	a.Y *= b.Y
+	a.Z *= b.Z
+}
+
+// DivAssign sets a /= b component-wise.
+func (a *Vec3g[S]) DivAssign(b Vec3g[S]) {
+	a.X /= b.X
+	a.Y /= b.Y
+	a.Z /= b.Z
+}
+
+// AddsAssign adds s to each component of a.
+func (a *Vec3g[S]) AddsAssign(s S) {
+	a.X += s
+	a.Y += s
+	a.Z += s
+}
+
+// SubsAssign subtracts s from each component of a.
+func (a *Vec3g[S]) SubsAssign(s S) {
+	a.X -= s
+	a.Y -= s
+	a.Z -= s
+}
+
+// MulsAssign multiplies each component of a by s.
+func (a *Vec3g[S]) MulsAssign(s S) {
+	a.X *= s
+	a.Y *= s
+	a.Z *= s
+}
+
+// DivsAssign divides each component of a by s.
+func (a *Vec3g[S]) DivsAssign(s S) {
+	a.X /= s
+	a.Y /= s
+	a.Z /= s
+}
+
+// NegAssign negates a.
+func (a *Vec3g[S]) NegAssign() {
+	a.X = -a.X
+	a.Y = -a.Y
+	a.Z = -a.Z
+}
+
+// NormalizeAssign sets a to its unit vector, or zero if it has zero length.
+func (a *Vec3g[S]) NormalizeAssign() { *a = Normalize3(*a) }
+
+// Vec4
+// ---
+
+// AddAssign sets a += b.
+func (a *Vec4g[S]) AddAssign(b Vec4g[S]) {
+	a.X += b.X
+	a.Y += b.Y
+	a.Z += b.Z
+	a.W += b.W
+}
+
+// SubAssign sets a -= b.
+func (a *Vec4g[S]) SubAssign(b Vec4g[S]) {
+	a.X -= b.X
+	a.Y -= b.Y
+	a.Z -= b.Z
+	a.W -= b.W
+}
+
+// MulAssign sets a *= b component-wise.
+func (a *Vec4g[S]) MulAssign(b Vec4g[S]) {
+	a.X *= b.X
+	a.Y *= b.Y
+	a.Z *= b.Z
+	a.W *= b.W
+}
+
+// DivAssign sets a /= b component-wise.
+func (a *Vec4g[S]) DivAssign(b Vec4g[S]) {
+	a.X /= b.X
+	a.Y /= b.Y
+	a.Z /= b.Z
+	a.W /= b.W
+}
+
+// AddsAssign adds s to each component of a.
+func (a *Vec4g[S]) AddsAssign(s S) {
+	a.X += s
+	a.Y += s
+	a.Z += s
+	a.W += s
+}
+
+// SubsAssign subtracts s from each component of a.
+func (a *Vec4g[S]) SubsAssign(s S) {
+	a.X -= s
+	a.Y -= s
+	a.Z -= s
+	a.W -= s
+}
+
+// MulsAssign multiplies each component of a by s.
+func (a *Vec4g[S]) MulsAssign(s S) {
+	a.X *= s
+	a.Y *= s
+	a.Z *= s
+	a.W *= s
+}
+
+// DivsAssign divides each component of a by s.
+func (a *Vec4g[S]) DivsAssign(s S) {
+	a.X /= s
+	a.Y /= s
+	a.Z /= s
+	a.W /= s
+}
+
+// NegAssign negates a.
+func (a *Vec4g[S]) NegAssign() {
+	a.X = -a.X
+	a.Y = -a.Y
+	a.Z = -a.Z
+	a.W = -a.W
+}
+
+// NormalizeAssign sets a to its unit vector, or zero if it has zero length.
+func (a *Vec4g[S]) NormalizeAssign() { *a = Normalize4(*a) }
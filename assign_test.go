@@ -0,0 +1,59 @@
+package vec_test
+
+import (
+	"testing"
+
+	"github.com/eihigh/vec"
+)
+
+// The benchmarks compare a particle update written with value methods
+// against the same update written with the in-place methods.
+
+var gravity = vec.Vec3g[float32]{0, -0.001, 0}
+
+func benchParticles() (pos, vel []vec.Vec3g[float32]) {
+	pos = make([]vec.Vec3g[float32], 4096)
+	vel = make([]vec.Vec3g[float32], len(pos))
+	for i := range vel {
+		vel[i] = vec.Vec3g[float32]{float32(i), 1, -1}
+	}
+	return pos, vel
+}
+
+func BenchmarkUpdateValue(b *testing.B) {
+	pos, vel := benchParticles()
+	for b.Loop() {
+		for i := range pos {
+			vel[i] = vel[i].Add(gravity)
+			pos[i] = pos[i].Add(vel[i])
+		}
+	}
+}
+
+func BenchmarkUpdateAssign(b *testing.B) {
+	pos, vel := benchParticles()
+	for b.Loop() {
+		for i := range pos {
+			vel[i].AddAssign(gravity)
+			pos[i].AddAssign(vel[i])
+		}
+	}
+}
+
+func BenchmarkNormalizeValue(b *testing.B) {
+	_, vel := benchParticles()
+	for b.Loop() {
+		for i := range vel {
+			vel[i] = vec.Normalize3(vel[i])
+		}
+	}
+}
+
+func BenchmarkNormalizeAssign(b *testing.B) {
+	_, vel := benchParticles()
+	for b.Loop() {
+		for i := range vel {
+			vel[i].NormalizeAssign()
+		}
+	}
+}
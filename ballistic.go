@@ -0,0 +1,99 @@
+package vec
+
+import "math"
+
+// The functions below describe projectiles moving under constant gravity,
+// given as an acceleration vector such as Vec2{0, -9.8}, so that they
+// work in 2D and 3D and with either Y up or Y down. Heights are measured
+// against gravity. Air resistance is ignored.
+
+// BallisticPosition returns the position at time t of a projectile launched
+// from from with velocity v.
+func BallisticPosition[V Vector[V, float64]](from, v, gravity V, t float64) V {
+	return from.Add(v.Scale(t)).Add(gravity.Scale(t * t / 2))
+}
+
+// LaunchVelocity returns the velocities of the given speed that carry a
+// projectile from from to to. low is the flatter and faster of the two
+// trajectories and high the lob; they are equal when speed is the minimum
+// needed. Returns false if to is out of reach at that speed.
+func LaunchVelocity[V Vector[V, float64]](from, to V, speed float64, gravity V) (low, high V, ok bool) {
+	// With T the squared time of flight, |d - g*T/2|² = speed² * T is a
+	// quadratic in T.
+	d := to.Sub(from)
+	gg, dg, dd := Dot(gravity, gravity), Dot(d, gravity), Dot(d, d)
+	b := dg + speed*speed
+	if dd == 0 || speed <= 0 {
+		return low, high, false
+	}
+	if gg == 0 {
+		v := d.Scale(speed / math.Sqrt(dd))
+		return v, v, true
+	}
+	disc := b*b - gg*dd
+	if disc < 0 || b <= 0 {
+		return low, high, false
+	}
+	sq := math.Sqrt(disc)
+	velocity := func(T float64) V {
+		t := math.Sqrt(T)
+		return d.Sub(gravity.Scale(T / 2)).Scale(1 / t)
+	}
+	return velocity(2 * (b - sq) / gg), velocity(2 * (b + sq) / gg), true
+}
+
+// LaunchVelocityApex returns the velocity that carries a projectile from
+// from to to with the top of its arc apex above from, and the time of
+// flight. Returns false if gravity is zero or to is higher than the apex.
+func LaunchVelocityApex[V Vector[V, float64]](from, to V, apex float64, gravity V) (v V, t float64, ok bool) {
+	g := Len(gravity)
+	if g == 0 || apex < 0 {
+		return v, 0, false
+	}
+	up := gravity.Scale(-1 / g)
+	d := to.Sub(from)
+	h := Dot(d, up)
+	if h > apex {
+		return v, 0, false
+	}
+	vUp := math.Sqrt(2 * g * apex)
+	t = vUp/g + math.Sqrt(2*(apex-h)/g)
+	if t == 0 {
+		return v, 0, false
+	}
+	across := d.Sub(up.Scale(h))
+	return across.Scale(1 / t).Add(up.Scale(vUp)), t, true
+}
+
+// TimeOfFlight returns when a projectile launched with velocity v comes
+// down to height above its launch point, such as 0 for level ground.
+// Returns false if the projectile never gets that high.
+func TimeOfFlight[V Vector[V, float64]](v, gravity V, height float64) (float64, bool) {
+	g := Len(gravity)
+	if g == 0 {
+		return 0, false
+	}
+	// Solve vUp*t - g*t²/2 = height for the later root.
+	vUp := -Dot(v, gravity) / g
+	disc := vUp*vUp - 2*g*height
+	if disc < 0 {
+		return 0, false
+	}
+	t := (vUp + math.Sqrt(disc)) / g
+	return t, t >= 0
+}
+
+// SampleTrajectory returns n positions of a projectile launched from from
+// with velocity v, evenly spaced in time from 0 to duration, as for drawing
+// its predicted arc.
+func SampleTrajectory[V Vector[V, float64]](from, v, gravity V, duration float64, n int) []V {
+	ps := make([]V, n)
+	for i := range ps {
+		t := duration
+		if n > 1 {
+			t = duration * float64(i) / float64(n-1)
+		}
+		ps[i] = BallisticPosition(from, v, gravity, t)
+	}
+	return ps
+}
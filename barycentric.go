@@ -0,0 +1,55 @@
+package vec
+
+// Barycentric2 returns the barycentric coordinates of p with respect to the
+// triangle abc, so that p = u*a + v*b + w*c and u + v + w = 1.
+// p is inside the triangle when all three are non-negative.
+// The result is NaN or infinite if the triangle is degenerate.
+func Barycentric2(p, a, b, c Vec2) (u, v, w float64) {
+	d := Cross2(b.Sub(a), c.Sub(a))
+	v = Cross2(p.Sub(a), c.Sub(a)) / d
+	w = Cross2(b.Sub(a), p.Sub(a)) / d
+	return 1 - v - w, v, w
+}
+
+// Barycentric3 returns the barycentric coordinates with respect to the
+// triangle abc of the projection of p onto the triangle's plane.
+// See Barycentric2.
+func Barycentric3(p, a, b, c Vec3) (u, v, w float64) {
+	e0, e1, ep := b.Sub(a), c.Sub(a), p.Sub(a)
+	d00, d01, d11 := Dot3(e0, e0), Dot3(e0, e1), Dot3(e1, e1)
+	d20, d21 := Dot3(ep, e0), Dot3(ep, e1)
+	d := d00*d11 - d01*d01
+	v = (d11*d20 - d01*d21) / d
+	w = (d00*d21 - d01*d20) / d
+	return 1 - v - w, v, w
+}
+
+// InterpolateBarycentric2 returns the attribute u*a + v*b + w*c.
+func InterpolateBarycentric2[V Vec2like[S], S Scalar](a, b, c V, u, v, w float64) V {
+	va, vb, vc := Vec2g[S](a), Vec2g[S](b), Vec2g[S](c)
+	return V(Vec2g[S]{
+		X: S(u*float64(va.X) + v*float64(vb.X) + w*float64(vc.X)),
+		Y: S(u*float64(va.Y) + v*float64(vb.Y) + w*float64(vc.Y)),
+	})
+}
+
+// InterpolateBarycentric3 returns the attribute u*a + v*b + w*c.
+func InterpolateBarycentric3[V Vec3like[S], S Scalar](a, b, c V, u, v, w float64) V {
+	va, vb, vc := Vec3g[S](a), Vec3g[S](b), Vec3g[S](c)
+	return V(Vec3g[S]{
+		X: S(u*float64(va.X) + v*float64(vb.X) + w*float64(vc.X)),
+		Y: S(u*float64(va.Y) + v*float64(vb.Y) + w*float64(vc.Y)),
+		Z: S(u*float64(va.Z) + v*float64(vb.Z) + w*float64(vc.Z)),
+	})
+}
+
+// InterpolateBarycentric4 returns the attribute u*a + v*b + w*c.
+func InterpolateBarycentric4[V Vec4like[S], S Scalar](a, b, c V, u, v, w float64) V {
+	va, vb, vc := Vec4g[S](a), Vec4g[S](b), Vec4g[S](c)
+	return V(Vec4g[S]{
+		X: S(u*float64(va.X) + v*float64(vb.X) + w*float64(vc.X)),
+		Y: S(u*float64(va.Y) + v*float64(vb.Y) + w*float64(vc.Y)),
+		Z: S(u*float64(va.Z) + v*float64(vb.Z) + w*float64(vc.Z)),
+		W: S(u*float64(va.W) + v*float64(vb.W) + w*float64(vc.W)),
+	})
+}
@@ -0,0 +1,122 @@
+package vec
+
+// Batch kernels for float32 vector slices. On amd64 they run SSE assembly
+// and on arm64 NEON assembly; elsewhere, or with the purego build tag, they
+// fall back to Go loops that produce identical results. dst may alias the inputs. All slices passed
+// to one call must have the same length, or the kernels panic.
+
+// AddSlices4 sets dst[i] = a[i] + b[i].
+func AddSlices4(dst, a, b []Vec4g[float32]) {
+	checkBatchLen(len(dst), len(a), len(b))
+	addFloat32s(AsScalars4(dst), AsScalars4(a), AsScalars4(b))
+}
+
+// AddSlices3 sets dst[i] = a[i] + b[i].
+func AddSlices3(dst, a, b []Vec3g[float32]) {
+	checkBatchLen(len(dst), len(a), len(b))
+	addFloat32s(AsScalars3(dst), AsScalars3(a), AsScalars3(b))
+}
+
+// ScaleSlice4 sets dst[i] = src[i] * s.
+func ScaleSlice4(dst, src []Vec4g[float32], s float32) {
+	checkBatchLen(len(dst), len(src), len(src))
+	scaleFloat32s(AsScalars4(dst), AsScalars4(src), s)
+}
+
+// ScaleSlice3 sets dst[i] = src[i] * s.
+func ScaleSlice3(dst, src []Vec3g[float32], s float32) {
+	checkBatchLen(len(dst), len(src), len(src))
+	scaleFloat32s(AsScalars3(dst), AsScalars3(src), s)
+}
+
+// DotSlices4 sets dst[i] to the dot product of a[i] and b[i].
+func DotSlices4(dst []float32, a, b []Vec4g[float32]) {
+	checkBatchLen(len(dst), len(a), len(b))
+	dot4s(dst, AsScalars4(a), AsScalars4(b))
+}
+
+// DotSlices3 sets dst[i] to the dot product of a[i] and b[i].
+func DotSlices3(dst []float32, a, b []Vec3g[float32]) {
+	checkBatchLen(len(dst), len(a), len(b))
+	dot3s(dst, AsScalars3(a), AsScalars3(b))
+}
+
+// TransformSlice4 sets dst[i] = m * src[i]. m is rounded to float32 first.
+func TransformSlice4(dst, src []Vec4g[float32], m Mat4) {
+	checkBatchLen(len(dst), len(src), len(src))
+	cols := columns32(m)
+	transform4s(AsScalars4(dst), AsScalars4(src), &cols)
+}
+
+// TransformSlice3 sets dst[i] to the point src[i] transformed by the affine
+// matrix m, treating src[i] as (x, y, z, 1) and dropping the resulting w.
+// m is rounded to float32 first.
+func TransformSlice3(dst, src []Vec3g[float32], m Mat4) {
+	checkBatchLen(len(dst), len(src), len(src))
+	cols := columns32(m)
+	transform3s(AsScalars3(dst), AsScalars3(src), &cols)
+}
+
+func checkBatchLen(dst, a, b int) {
+	if dst != a || dst != b {
+		panic("vec: batch slice length mismatch")
+	}
+}
+
+// columns32 returns m as float32 in column-major order.
+func columns32(m Mat4) [16]float32 {
+	var c [16]float32
+	for j := range 4 {
+		for i := range 4 {
+			c[4*j+i] = float32(m[i][j])
+		}
+	}
+	return c
+}
+
+// Go implementations of the kernels. The assembly versions sum products in
+// the same order, so results match bit for bit.
+
+func addFloat32sGo(dst, a, b []float32) {
+	for i := range dst {
+		dst[i] = a[i] + b[i]
+	}
+}
+
+func scaleFloat32sGo(dst, src []float32, s float32) {
+	for i := range dst {
+		dst[i] = src[i] * s
+	}
+}
+
+func dot4sGo(dst, a, b []float32) {
+	for i := range dst {
+		a, b := a[4*i:4*i+4], b[4*i:4*i+4]
+		dst[i] = (float32(a[0]*b[0]) + float32(a[1]*b[1])) + (float32(a[2]*b[2]) + float32(a[3]*b[3]))
+	}
+}
+
+func dot3sGo(dst, a, b []float32) {
+	for i := range dst {
+		a, b := a[3*i:3*i+3], b[3*i:3*i+3]
+		dst[i] = float32(a[0]*b[0]) + float32(a[1]*b[1]) + float32(a[2]*b[2])
+	}
+}
+
+func transform3sGo(dst, src []float32, c *[16]float32) {
+	for i := 0; i < len(src); i += 3 {
+		x, y, z := src[i], src[i+1], src[i+2]
+		for k := range 3 {
+			dst[i+k] = float32(x*c[k]) + float32(y*c[4+k]) + (float32(z*c[8+k]) + c[12+k])
+		}
+	}
+}
+
+func transform4sGo(dst, src []float32, c *[16]float32) {
+	for i := 0; i < len(src); i += 4 {
+		x, y, z, w := src[i], src[i+1], src[i+2], src[i+3]
+		for k := range 4 {
+			dst[i+k] = (float32(x*c[k]) + float32(y*c[4+k])) + (float32(z*c[8+k]) + float32(w*c[12+k]))
+		}
+	}
+}
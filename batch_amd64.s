@@ -0,0 +1,206 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// func addFloat32s(dst, a, b []float32)
+TEXT ·addFloat32s(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+add4:
+	LEAQ 4(AX), BX
+	CMPQ BX, CX
+	JGT  add1
+	MOVUPS (SI)(AX*4), X0
+	MOVUPS (DX)(AX*4), X1
+	ADDPS  X1, X0
+	MOVUPS X0, (DI)(AX*4)
+	MOVQ   BX, AX
+	JMP    add4
+
+add1:
+	CMPQ  AX, CX
+	JGE   addDone
+	MOVSS (SI)(AX*4), X0
+	ADDSS (DX)(AX*4), X0
+	MOVSS X0, (DI)(AX*4)
+	INCQ  AX
+	JMP   add1
+
+addDone:
+	RET
+
+// func scaleFloat32s(dst, src []float32, s float32)
+TEXT ·scaleFloat32s(SB), NOSPLIT, $0-52
+	MOVQ   dst_base+0(FP), DI
+	MOVQ   dst_len+8(FP), CX
+	MOVQ   src_base+24(FP), SI
+	MOVSS  s+48(FP), X2
+	SHUFPS $0x00, X2, X2
+	XORQ   AX, AX
+
+scale4:
+	LEAQ 4(AX), BX
+	CMPQ BX, CX
+	JGT  scale1
+	MOVUPS (SI)(AX*4), X0
+	MULPS  X2, X0
+	MOVUPS X0, (DI)(AX*4)
+	MOVQ   BX, AX
+	JMP    scale4
+
+scale1:
+	CMPQ  AX, CX
+	JGE   scaleDone
+	MOVSS (SI)(AX*4), X0
+	MULSS X2, X0
+	MOVSS X0, (DI)(AX*4)
+	INCQ  AX
+	JMP   scale1
+
+scaleDone:
+	RET
+
+// func dot4s(dst, a, b []float32)
+TEXT ·dot4s(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+	XORQ AX, AX
+
+dotLoop:
+	CMPQ   AX, CX
+	JGE    dotDone
+	MOVUPS (SI), X0
+	MOVUPS (DX), X1
+	MULPS  X1, X0
+
+	// (x+y, y+x, z+w, w+z), then add the two halves.
+	MOVAPS X0, X1
+	SHUFPS $0xB1, X1, X1
+	ADDPS  X1, X0
+	MOVAPS X0, X1
+	SHUFPS $0x4E, X1, X1
+	ADDSS  X1, X0
+	MOVSS  X0, (DI)(AX*4)
+
+	ADDQ $16, SI
+	ADDQ $16, DX
+	INCQ AX
+	JMP  dotLoop
+
+dotDone:
+	RET
+
+// func transform4s(dst, src []float32, c *[16]float32)
+TEXT ·transform4s(SB), NOSPLIT, $0-56
+	MOVQ   dst_base+0(FP), DI
+	MOVQ   src_base+24(FP), SI
+	MOVQ   src_len+32(FP), CX
+	MOVQ   c+48(FP), DX
+	MOVUPS 0(DX), X4
+	MOVUPS 16(DX), X5
+	MOVUPS 32(DX), X6
+	MOVUPS 48(DX), X7
+	SHRQ   $2, CX
+
+transformLoop:
+	TESTQ  CX, CX
+	JZ     transformDone
+	MOVUPS (SI), X0
+	MOVAPS X0, X1
+	SHUFPS $0x00, X1, X1
+	MOVAPS X0, X2
+	SHUFPS $0x55, X2, X2
+	MOVAPS X0, X3
+	SHUFPS $0xAA, X3, X3
+	SHUFPS $0xFF, X0, X0
+	MULPS  X4, X1
+	MULPS  X5, X2
+	MULPS  X6, X3
+	MULPS  X7, X0
+	ADDPS  X2, X1
+	ADDPS  X0, X3
+	ADDPS  X3, X1
+	MOVUPS X1, (DI)
+
+	ADDQ $16, SI
+	ADDQ $16, DI
+	DECQ CX
+	JMP  transformLoop
+
+transformDone:
+	RET
+
+// func dot3s(dst, a, b []float32)
+TEXT ·dot3s(SB), NOSPLIT, $0-72
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ a_base+24(FP), SI
+	MOVQ b_base+48(FP), DX
+
+dot3Loop:
+	TESTQ CX, CX
+	JZ    dot3Done
+	MOVSS 0(SI), X0
+	MULSS 0(DX), X0
+	MOVSS 4(SI), X1
+	MULSS 4(DX), X1
+	ADDSS X1, X0
+	MOVSS 8(SI), X1
+	MULSS 8(DX), X1
+	ADDSS X1, X0
+	MOVSS X0, (DI)
+
+	ADDQ $12, SI
+	ADDQ $12, DX
+	ADDQ $4, DI
+	DECQ CX
+	JMP  dot3Loop
+
+dot3Done:
+	RET
+
+// func transform3s(dst, src []float32, c *[16]float32)
+TEXT ·transform3s(SB), NOSPLIT, $0-56
+	MOVQ   dst_base+0(FP), DI
+	MOVQ   src_base+24(FP), SI
+	MOVQ   src_len+32(FP), CX
+	MOVQ   c+48(FP), DX
+	MOVUPS 0(DX), X4
+	MOVUPS 16(DX), X5
+	MOVUPS 32(DX), X6
+	MOVUPS 48(DX), X7
+
+transform3Loop:
+	CMPQ   CX, $3
+	JLT    transform3Done
+	MOVSS  0(SI), X1
+	SHUFPS $0x00, X1, X1
+	MOVSS  4(SI), X2
+	SHUFPS $0x00, X2, X2
+	MOVSS  8(SI), X3
+	SHUFPS $0x00, X3, X3
+	MULPS  X4, X1
+	MULPS  X5, X2
+	MULPS  X6, X3
+	ADDPS  X2, X1
+	ADDPS  X7, X3
+	ADDPS  X3, X1
+
+	// Store x and y, then z, leaving the next vertex untouched.
+	MOVSD   X1, (DI)
+	MOVHLPS X1, X1
+	MOVSS   X1, 8(DI)
+
+	ADDQ $12, SI
+	ADDQ $12, DI
+	SUBQ $3, CX
+	JMP  transform3Loop
+
+transform3Done:
+	RET
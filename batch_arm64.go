@@ -0,0 +1,21 @@
+//go:build !purego
+
+package vec
+
+//go:noescape
+func addFloat32s(dst, a, b []float32)
+
+//go:noescape
+func scaleFloat32s(dst, src []float32, s float32)
+
+//go:noescape
+func dot4s(dst, a, b []float32)
+
+//go:noescape
+func dot3s(dst, a, b []float32)
+
+//go:noescape
+func transform3s(dst, src []float32, c *[16]float32)
+
+//go:noescape
+func transform4s(dst, src []float32, c *[16]float32)
@@ -0,0 +1,196 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// Vector float arithmetic on four single-precision lanes, encoded directly
+// so that the file assembles with older toolchains: Vd = Vn op Vm.
+#define VFADD4S(m, n, d) WORD $(0x4E20D400 | (m)<<16 | (n)<<5 | (d))
+#define VFMUL4S(m, n, d) WORD $(0x6E20DC00 | (m)<<16 | (n)<<5 | (d))
+// Pairwise add: Vd = (n0+n1, n2+n3, m0+m1, m2+m3).
+#define VFADDP4S(m, n, d) WORD $(0x6E20D400 | (m)<<16 | (n)<<5 | (d))
+
+// func addFloat32s(dst, a, b []float32)
+TEXT ·addFloat32s(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R1
+	MOVD a_base+24(FP), R2
+	MOVD b_base+48(FP), R3
+
+add4:
+	CMP    $4, R1
+	BLT    add1
+	VLD1.P 16(R2), [V0.S4]
+	VLD1.P 16(R3), [V1.S4]
+	VFADD4S(1, 0, 0)
+	VST1.P [V0.S4], 16(R0)
+	SUB    $4, R1
+	B      add4
+
+add1:
+	CBZ   R1, addDone
+	FMOVS (R2), F0
+	FMOVS (R3), F1
+	FADDS F1, F0
+	FMOVS F0, (R0)
+	ADD   $4, R2
+	ADD   $4, R3
+	ADD   $4, R0
+	SUB   $1, R1
+	B     add1
+
+addDone:
+	RET
+
+// func scaleFloat32s(dst, src []float32, s float32)
+TEXT ·scaleFloat32s(SB), NOSPLIT, $0-52
+	MOVD  dst_base+0(FP), R0
+	MOVD  dst_len+8(FP), R1
+	MOVD  src_base+24(FP), R2
+	FMOVS s+48(FP), F2
+	VDUP  V2.S[0], V2.S4
+
+scale4:
+	CMP    $4, R1
+	BLT    scale1
+	VLD1.P 16(R2), [V0.S4]
+	VFMUL4S(2, 0, 0)
+	VST1.P [V0.S4], 16(R0)
+	SUB    $4, R1
+	B      scale4
+
+scale1:
+	CBZ   R1, scaleDone
+	FMOVS (R2), F0
+	FMULS F2, F0
+	FMOVS F0, (R0)
+	ADD   $4, R2
+	ADD   $4, R0
+	SUB   $1, R1
+	B     scale1
+
+scaleDone:
+	RET
+
+// func dot4s(dst, a, b []float32)
+TEXT ·dot4s(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R1
+	MOVD a_base+24(FP), R2
+	MOVD b_base+48(FP), R3
+
+dotLoop:
+	CBZ    R1, dotDone
+	VLD1.P 16(R2), [V0.S4]
+	VLD1.P 16(R3), [V1.S4]
+	VFMUL4S(1, 0, 0)
+
+	// (x+y, z+w, ...), then add the two halves.
+	VFADDP4S(0, 0, 0)
+	VFADDP4S(0, 0, 0)
+	FMOVS F0, (R0)
+
+	ADD $4, R0
+	SUB $1, R1
+	B   dotLoop
+
+dotDone:
+	RET
+
+// func dot3s(dst, a, b []float32)
+TEXT ·dot3s(SB), NOSPLIT, $0-72
+	MOVD dst_base+0(FP), R0
+	MOVD dst_len+8(FP), R1
+	MOVD a_base+24(FP), R2
+	MOVD b_base+48(FP), R3
+
+dot3Loop:
+	CBZ   R1, dot3Done
+	FMOVS 0(R2), F0
+	FMOVS 0(R3), F1
+	FMULS F1, F0
+	FMOVS 4(R2), F1
+	FMOVS 4(R3), F2
+	FMULS F2, F1
+	FADDS F1, F0
+	FMOVS 8(R2), F1
+	FMOVS 8(R3), F2
+	FMULS F2, F1
+	FADDS F1, F0
+	FMOVS F0, (R0)
+
+	ADD $12, R2
+	ADD $12, R3
+	ADD $4, R0
+	SUB $1, R1
+	B   dot3Loop
+
+dot3Done:
+	RET
+
+// func transform4s(dst, src []float32, c *[16]float32)
+TEXT ·transform4s(SB), NOSPLIT, $0-56
+	MOVD dst_base+0(FP), R0
+	MOVD src_base+24(FP), R2
+	MOVD src_len+32(FP), R1
+	MOVD c+48(FP), R3
+	VLD1 (R3), [V4.S4, V5.S4, V6.S4, V7.S4]
+	LSR  $2, R1
+
+transformLoop:
+	CBZ    R1, transformDone
+	VLD1.P 16(R2), [V0.S4]
+	VDUP   V0.S[0], V1.S4
+	VDUP   V0.S[1], V2.S4
+	VDUP   V0.S[2], V3.S4
+	VDUP   V0.S[3], V0.S4
+	VFMUL4S(4, 1, 1)
+	VFMUL4S(5, 2, 2)
+	VFMUL4S(6, 3, 3)
+	VFMUL4S(7, 0, 0)
+	VFADD4S(2, 1, 1)
+	VFADD4S(0, 3, 3)
+	VFADD4S(3, 1, 1)
+	VST1.P [V1.S4], 16(R0)
+
+	SUB $1, R1
+	B   transformLoop
+
+transformDone:
+	RET
+
+// func transform3s(dst, src []float32, c *[16]float32)
+TEXT ·transform3s(SB), NOSPLIT, $0-56
+	MOVD dst_base+0(FP), R0
+	MOVD src_base+24(FP), R2
+	MOVD src_len+32(FP), R1
+	MOVD c+48(FP), R3
+	VLD1 (R3), [V4.S4, V5.S4, V6.S4, V7.S4]
+
+transform3Loop:
+	CMP   $3, R1
+	BLT   transform3Done
+	FMOVS 0(R2), F1
+	FMOVS 4(R2), F2
+	FMOVS 8(R2), F3
+	VDUP  V1.S[0], V1.S4
+	VDUP  V2.S[0], V2.S4
+	VDUP  V3.S[0], V3.S4
+	VFMUL4S(4, 1, 1)
+	VFMUL4S(5, 2, 2)
+	VFMUL4S(6, 3, 3)
+	VFADD4S(2, 1, 1)
+	VFADD4S(7, 3, 3)
+	VFADD4S(3, 1, 1)
+
+	// Store x and y, then z, leaving the next vertex untouched.
+	FMOVD F1, 0(R0)
+	VMOV  V1.S[2], R4
+	MOVW  R4, 8(R0)
+
+	ADD $12, R2
+	ADD $12, R0
+	SUB $3, R1
+	B   transform3Loop
+
+transform3Done:
+	RET
@@ -0,0 +1,15 @@
+//go:build !(amd64 || arm64) || purego
+
+package vec
+
+func addFloat32s(dst, a, b []float32) { addFloat32sGo(dst, a, b) }
+
+func scaleFloat32s(dst, src []float32, s float32) { scaleFloat32sGo(dst, src, s) }
+
+func dot4s(dst, a, b []float32) { dot4sGo(dst, a, b) }
+
+func dot3s(dst, a, b []float32) { dot3sGo(dst, a, b) }
+
+func transform3s(dst, src []float32, c *[16]float32) { transform3sGo(dst, src, c) }
+
+func transform4s(dst, src []float32, c *[16]float32) { transform4sGo(dst, src, c) }
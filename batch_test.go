@@ -0,0 +1,82 @@
+package vec
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// The tests check that the assembly kernels match the Go loops bit for bit,
+// over lengths that exercise the vector loops and their scalar tails, and
+// with dst aliasing an input.
+
+func randFloat32s(r *rand.Rand, n int) []float32 {
+	s := make([]float32, n)
+	for i := range s {
+		s[i] = float32(r.NormFloat64() * 100)
+	}
+	return s
+}
+
+func equalBits(a, b []float32) bool {
+	return slices.EqualFunc(a, b, func(x, y float32) bool { return math.Float32bits(x) == math.Float32bits(y) })
+}
+
+func TestBatchKernels(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	var c [16]float32
+	copy(c[:], randFloat32s(r, 16))
+	for n := range 70 {
+		a, b := randFloat32s(r, 4*n), randFloat32s(r, 4*n)
+		check := func(name string, got, want []float32) {
+			t.Helper()
+			if !equalBits(got, want) {
+				t.Errorf("%s, n=%d: got %v, want %v", name, n, got, want)
+			}
+		}
+		kernel := func(name string, size int, asm, pure func(dst, src []float32)) {
+			t.Helper()
+			src := a[:size]
+			want := make([]float32, len(src))
+			pure(want, src)
+			got := make([]float32, len(src))
+			asm(got, src)
+			check(name, got, want)
+			aliased := slices.Clone(src)
+			asm(aliased, aliased)
+			check(name+" aliased", aliased, want)
+		}
+
+		kernel("addFloat32s", n,
+			func(dst, src []float32) { addFloat32s(dst, src, b[:n]) },
+			func(dst, src []float32) { addFloat32sGo(dst, src, b[:n]) })
+		kernel("scaleFloat32s", n,
+			func(dst, src []float32) { scaleFloat32s(dst, src, 1.7) },
+			func(dst, src []float32) { scaleFloat32sGo(dst, src, 1.7) })
+		kernel("transform4s", 4*n,
+			func(dst, src []float32) { transform4s(dst, src, &c) },
+			func(dst, src []float32) { transform4sGo(dst, src, &c) })
+		kernel("transform3s", 3*n,
+			func(dst, src []float32) { transform3s(dst, src, &c) },
+			func(dst, src []float32) { transform3sGo(dst, src, &c) })
+
+		got, want := make([]float32, n), make([]float32, n)
+		dot4s(got, a, b)
+		dot4sGo(want, a, b)
+		check("dot4s", got, want)
+		dot3s(got, a[:3*n], b[:3*n])
+		dot3sGo(want, a[:3*n], b[:3*n])
+		check("dot3s", got, want)
+	}
+}
+
+func TestTransform3sKeepsNextVertex(t *testing.T) {
+	// The kernel must store exactly three floats per vertex.
+	c := columns32(rigidMat4(Identity3(), Vec3{1, 2, 3}))
+	buf := []float32{1, 1, 1, 9}
+	transform3s(buf[:3], buf[:3], &c)
+	if want := []float32{2, 3, 4, 9}; !equalBits(buf, want) {
+		t.Errorf("got %v, want %v", buf, want)
+	}
+}
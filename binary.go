@@ -0,0 +1,134 @@
+package vec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Vectors encode to a compact fixed-size binary form: the components in
+// order, each little-endian in the width of S. int, uint and uintptr are
+// always written as 8 bytes so the encoding does not depend on the platform.
+// Because the types implement encoding.BinaryMarshaler, gob uses this form too.
+
+// AppendBinary implements encoding.BinaryAppender.
+func (a Vec2g[S]) AppendBinary(b []byte) ([]byte, error) {
+	b = appendScalar(b, a.X)
+	return appendScalar(b, a.Y), nil
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+func (a Vec3g[S]) AppendBinary(b []byte) ([]byte, error) {
+	b = appendScalar(b, a.X)
+	b = appendScalar(b, a.Y)
+	return appendScalar(b, a.Z), nil
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+func (a Vec4g[S]) AppendBinary(b []byte) ([]byte, error) {
+	b = appendScalar(b, a.X)
+	b = appendScalar(b, a.Y)
+	b = appendScalar(b, a.Z)
+	return appendScalar(b, a.W), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a Vec2g[S]) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 2*scalarSize[S]()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a Vec3g[S]) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 3*scalarSize[S]()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a Vec4g[S]) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 4*scalarSize[S]()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// data must be exactly the size written by MarshalBinary.
+func (a *Vec2g[S]) UnmarshalBinary(data []byte) error {
+	var c [2]S
+	if err := readScalars(data, c[:]); err != nil {
+		return err
+	}
+	*a = Vec2g[S]{c[0], c[1]}
+	return nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// data must be exactly the size written by MarshalBinary.
+func (a *Vec3g[S]) UnmarshalBinary(data []byte) error {
+	var c [3]S
+	if err := readScalars(data, c[:]); err != nil {
+		return err
+	}
+	*a = Vec3g[S]{c[0], c[1], c[2]}
+	return nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// data must be exactly the size written by MarshalBinary.
+func (a *Vec4g[S]) UnmarshalBinary(data []byte) error {
+	var c [4]S
+	if err := readScalars(data, c[:]); err != nil {
+		return err
+	}
+	*a = Vec4g[S]{c[0], c[1], c[2], c[3]}
+	return nil
+}
+
+// scalarSize returns the encoded size of S in bytes.
+func scalarSize[S Scalar]() int {
+	t := reflect.TypeFor[S]()
+	switch t.Kind() {
+	case reflect.Int, reflect.Uint, reflect.Uintptr:
+		return 8
+	}
+	return int(t.Size())
+}
+
+func appendScalar[S Scalar](b []byte, x S) []byte {
+	switch n := scalarSize[S](); {
+	case isFloat[S]() && n == 4:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(x)))
+	case isFloat[S]():
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(float64(x)))
+	case n == 1:
+		return append(b, byte(x))
+	case n == 2:
+		return binary.LittleEndian.AppendUint16(b, uint16(x))
+	case n == 4:
+		return binary.LittleEndian.AppendUint32(b, uint32(x))
+	default:
+		return binary.LittleEndian.AppendUint64(b, uint64(x))
+	}
+}
+
+func readScalars[S Scalar](data []byte, dst []S) error {
+	n := scalarSize[S]()
+	if len(data) != n*len(dst) {
+		return fmt.Errorf("vec: binary data has %d bytes, want %d", len(data), n*len(dst))
+	}
+	for i := range dst {
+		b := data[i*n:]
+		switch {
+		case isFloat[S]() && n == 4:
+			dst[i] = S(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		case isFloat[S]():
+			dst[i] = S(math.Float64frombits(binary.LittleEndian.Uint64(b)))
+		case n == 1:
+			dst[i] = S(int8(b[0]))
+		case n == 2:
+			dst[i] = S(int16(binary.LittleEndian.Uint16(b)))
+		case n == 4:
+			dst[i] = S(int32(binary.LittleEndian.Uint32(b)))
+		default:
+			dst[i] = S(int64(binary.LittleEndian.Uint64(b)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package vec
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// OBB2 is an oriented rectangle centered at Center with half extents
+// HalfSize along its local axes, rotated counter-clockwise by Angle radians.
+type OBB2 struct {
+	Center   Vec2
+	HalfSize Vec2
+	Angle    float64
+}
+
+// Axes returns the unit vectors of the local X and Y axes of o.
+func (o OBB2) Axes() (u, v Vec2) {
+	sin, cos := math.Sincos(o.Angle)
+	return Vec2{cos, sin}, Vec2{-sin, cos}
+}
+
+// Corners returns the corners of o in counter-clockwise order.
+func (o OBB2) Corners() [4]Vec2 {
+	u, v := o.Axes()
+	u, v = u.Scale(o.HalfSize.X), v.Scale(o.HalfSize.Y)
+	return [4]Vec2{
+		o.Center.Sub(u).Sub(v),
+		o.Center.Add(u).Sub(v),
+		o.Center.Add(u).Add(v),
+		o.Center.Sub(u).Add(v),
+	}
+}
+
+// Area returns the area of o.
+func (o OBB2) Area() float64 { return 4 * o.HalfSize.X * o.HalfSize.Y }
+
+// MinBoundingCircle returns the smallest circle containing all points,
+// using Welzl's algorithm in its iterative form. Expected time is linear.
+// The points are shuffled with a fixed seed, so the result is the same on
+// every run. Returns zero circle if points is empty.
+func MinBoundingCircle(points []Vec2) Circle {
+	ps := slices.Clone(points)
+	rand.New(rand.NewPCG(1, 2)).Shuffle(len(ps), func(i, j int) { ps[i], ps[j] = ps[j], ps[i] })
+
+	var c Circle
+	for i, p := range ps {
+		if i > 0 && c.containsApprox(p) {
+			continue
+		}
+		c = Circle{p, 0}
+		for j, q := range ps[:i] {
+			if c.containsApprox(q) {
+				continue
+			}
+			c = circleFrom2(p, q)
+			for _, r := range ps[:j] {
+				if !c.containsApprox(r) {
+					c = circleFrom3(p, q, r)
+				}
+			}
+		}
+	}
+	return c
+}
+
+// containsApprox reports whether p lies in c, allowing for rounding error.
+func (c Circle) containsApprox(p Vec2) bool {
+	return Len2(p.Sub(c.Center)) <= c.Radius*(1+1e-12)+1e-12
+}
+
+// circleFrom2 returns the circle with diameter ab.
+func circleFrom2(a, b Vec2) Circle {
+	return Circle{Lerp2(a, b, 0.5), Len2(b.Sub(a)) / 2}
+}
+
+// circleFrom3 returns the circumcircle of abc, or the circle spanning the
+// farthest pair if they are collinear.
+func circleFrom3(a, b, c Vec2) Circle {
+	ab, ac := b.Sub(a), c.Sub(a)
+	d := 2 * Cross2(ab, ac)
+	if d == 0 {
+		best := circleFrom2(a, b)
+		for _, cand := range [2]Circle{circleFrom2(a, c), circleFrom2(b, c)} {
+			if cand.Radius > best.Radius {
+				best = cand
+			}
+		}
+		return best
+	}
+	lab, lac := LenSq2(ab), LenSq2(ac)
+	center := Vec2{ac.Y*lab - ab.Y*lac, ab.X*lac - ac.X*lab}.Divs(d)
+	return Circle{a.Add(center), Len2(center)}
+}
+
+// MinAreaOBB returns the oriented rectangle of minimum area containing all
+// points, found by rotating calipers over their convex hull.
+// The returned Angle is in [0, π/2).
+func MinAreaOBB(points []Vec2) OBB2 {
+	hull := ConvexHull2(points)
+	switch len(hull) {
+	case 0:
+		return OBB2{}
+	case 1:
+		return OBB2{Center: hull[0]}
+	case 2:
+		d := hull[1].Sub(hull[0])
+		return canonicalOBB(OBB2{Lerp2(hull[0], hull[1], 0.5), Vec2{Len2(d) / 2, 0}, Angle2(d)})
+	}
+
+	m := len(hull)
+	next := func(i int) int { return (i + 1) % m }
+	best := OBB2{}
+	bestArea := math.Inf(1)
+	var right, top, left int
+	for i := range m {
+		u := Normalize2(hull[next(i)].Sub(hull[i]))
+		v := Vec2{-u.Y, u.X}
+		du := func(k int) float64 { return Dot2(hull[k].Sub(hull[i]), u) }
+		dv := func(k int) float64 { return Dot2(hull[k].Sub(hull[i]), v) }
+
+		if i == 0 {
+			for k := range m {
+				if du(k) > du(right) {
+					right = k
+				}
+				if dv(k) > dv(top) {
+					top = k
+				}
+			}
+			left = top
+			for k := range m {
+				if du(k) < du(left) {
+					left = k
+				}
+			}
+		}
+		// Each caliper only ever moves forward as the edge rotates.
+		for range m {
+			if du(next(right)) <= du(right) {
+				break
+			}
+			right = next(right)
+		}
+		for range m {
+			if dv(next(top)) <= dv(top) {
+				break
+			}
+			top = next(top)
+		}
+		for range m {
+			if du(next(left)) >= du(left) {
+				break
+			}
+			left = next(left)
+		}
+
+		minU, maxU, maxV := du(left), du(right), dv(top)
+		if area := (maxU - minU) * maxV; area < bestArea {
+			bestArea = area
+			center := hull[i].Add(u.Scale((minU + maxU) / 2)).Add(v.Scale(maxV / 2))
+			best = OBB2{center, Vec2{(maxU - minU) / 2, maxV / 2}, Angle2(u)}
+		}
+	}
+	return canonicalOBB(best)
+}
+
+// canonicalOBB rotates the local frame of o by quarter turns so that its
+// angle lies in [0, π/2), swapping the half extents as needed.
+func canonicalOBB(o OBB2) OBB2 {
+	a := mod2Pi(o.Angle)
+	for a >= math.Pi/2 {
+		a -= math.Pi / 2
+		o.HalfSize = Vec2{o.HalfSize.Y, o.HalfSize.X}
+	}
+	o.Angle = a
+	return o
+}
@@ -0,0 +1,65 @@
+package vec
+
+type (
+	// Bounds2g accumulates the axis-aligned bounding rectangle of points
+	// and rectangles added one at a time. The zero value is empty and ready
+	// to use.
+	Bounds2g[S Scalar] struct {
+		r     Rectg[S]
+		valid bool
+	}
+
+	Bounds2  = Bounds2g[float64]
+	Bounds2i = Bounds2g[int]
+
+	// Bounds3g accumulates the axis-aligned bounding box of points and
+	// boxes added one at a time. The zero value is empty and ready to use.
+	Bounds3g[S Scalar] struct {
+		b     Boxg[S]
+		valid bool
+	}
+
+	Bounds3  = Bounds3g[float64]
+	Bounds3i = Bounds3g[int]
+)
+
+// Add extends b to contain p.
+func (b *Bounds2g[S]) Add(p Vec2g[S]) { b.AddRect(Rectg[S]{p, p}) }
+
+// AddRect extends b to contain r.
+func (b *Bounds2g[S]) AddRect(r Rectg[S]) {
+	if !b.valid {
+		b.r, b.valid = r, true
+		return
+	}
+	b.r.Min = Vec2g[S]{min(b.r.Min.X, r.Min.X), min(b.r.Min.Y, r.Min.Y)}
+	b.r.Max = Vec2g[S]{max(b.r.Max.X, r.Max.X), max(b.r.Max.Y, r.Max.Y)}
+}
+
+// IsEmpty reports whether nothing has been added to b.
+func (b Bounds2g[S]) IsEmpty() bool { return !b.valid }
+
+// Result returns the smallest rectangle containing everything added to b.
+// A single point gives a rectangle of zero size. Returns zero rectangle
+// if b is empty.
+func (b Bounds2g[S]) Result() Rectg[S] { return b.r }
+
+// Add extends b to contain p.
+func (b *Bounds3g[S]) Add(p Vec3g[S]) { b.AddBox(Boxg[S]{p, p}) }
+
+// AddBox extends b to contain box.
+func (b *Bounds3g[S]) AddBox(box Boxg[S]) {
+	if !b.valid {
+		b.b, b.valid = box, true
+		return
+	}
+	b.b.Min = Vec3g[S]{min(b.b.Min.X, box.Min.X), min(b.b.Min.Y, box.Min.Y), min(b.b.Min.Z, box.Min.Z)}
+	b.b.Max = Vec3g[S]{max(b.b.Max.X, box.Max.X), max(b.b.Max.Y, box.Max.Y), max(b.b.Max.Z, box.Max.Z)}
+}
+
+// IsEmpty reports whether nothing has been added to b.
+func (b Bounds3g[S]) IsEmpty() bool { return !b.valid }
+
+// Result returns the smallest box containing everything added to b.
+// A single point gives a box of zero size. Returns zero box if b is empty.
+func (b Bounds3g[S]) Result() Boxg[S] { return b.b }
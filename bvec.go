@@ -0,0 +1,222 @@
+package vec
+
+import "fmt"
+
+// BVec2, BVec3 and BVec4 are vectors of booleans, as produced by the
+// component-wise comparisons below. Together with Select they express
+// branchless component-wise logic, like GLSL's bvec types.
+type (
+	BVec2 struct{ X, Y bool }
+	BVec3 struct{ X, Y, Z bool }
+	BVec4 struct{ X, Y, Z, W bool }
+)
+
+// Any reports whether any component of m is true.
+func (m BVec2) Any() bool { return m.X || m.Y }
+
+// All reports whether every component of m is true.
+func (m BVec2) All() bool { return m.X && m.Y }
+
+// Not returns the component-wise negation of m.
+func (m BVec2) Not() BVec2 { return BVec2{!m.X, !m.Y} }
+
+// And returns the component-wise conjunction of m and o.
+func (m BVec2) And(o BVec2) BVec2 { return BVec2{m.X && o.X, m.Y && o.Y} }
+
+// Or returns the component-wise disjunction of m and o.
+func (m BVec2) Or(o BVec2) BVec2 { return BVec2{m.X || o.X, m.Y || o.Y} }
+
+// String returns m formatted as "(x, y)".
+func (m BVec2) String() string { return fmt.Sprintf("(%v, %v)", m.X, m.Y) }
+
+// Any reports whether any component of m is true.
+func (m BVec3) Any() bool { return m.X || m.Y || m.Z }
+
+// All reports whether every component of m is true.
+func (m BVec3) All() bool { return m.X && m.Y && m.Z }
+
+// Not returns the component-wise negation of m.
+func (m BVec3) Not() BVec3 { return BVec3{!m.X, !m.Y, !m.Z} }
+
+// And returns the component-wise conjunction of m and o.
+func (m BVec3) And(o BVec3) BVec3 { return BVec3{m.X && o.X, m.Y && o.Y, m.Z && o.Z} }
+
+// Or returns the component-wise disjunction of m and o.
+func (m BVec3) Or(o BVec3) BVec3 { return BVec3{m.X || o.X, m.Y || o.Y, m.Z || o.Z} }
+
+// String returns m formatted as "(x, y, z)".
+func (m BVec3) String() string { return fmt.Sprintf("(%v, %v, %v)", m.X, m.Y, m.Z) }
+
+// Any reports whether any component of m is true.
+func (m BVec4) Any() bool { return m.X || m.Y || m.Z || m.W }
+
+// All reports whether every component of m is true.
+func (m BVec4) All() bool { return m.X && m.Y && m.Z && m.W }
+
+// Not returns the component-wise negation of m.
+func (m BVec4) Not() BVec4 { return BVec4{!m.X, !m.Y, !m.Z, !m.W} }
+
+// And returns the component-wise conjunction of m and o.
+func (m BVec4) And(o BVec4) BVec4 { return BVec4{m.X && o.X, m.Y && o.Y, m.Z && o.Z, m.W && o.W} }
+
+// Or returns the component-wise disjunction of m and o.
+func (m BVec4) Or(o BVec4) BVec4 { return BVec4{m.X || o.X, m.Y || o.Y, m.Z || o.Z, m.W || o.W} }
+
+// String returns m formatted as "(x, y, z, w)".
+func (m BVec4) String() string { return fmt.Sprintf("(%v, %v, %v, %v)", m.X, m.Y, m.Z, m.W) }
+
+// LessThan2 reports for each component whether a is less than b.
+func LessThan2[V Vec2like[S], S Scalar](a, b V) BVec2 {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return BVec2{va.X < vb.X, va.Y < vb.Y}
+}
+
+// LessThan3 reports for each component whether a is less than b.
+func LessThan3[V Vec3like[S], S Scalar](a, b V) BVec3 {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return BVec3{va.X < vb.X, va.Y < vb.Y, va.Z < vb.Z}
+}
+
+// LessThan4 reports for each component whether a is less than b.
+func LessThan4[V Vec4like[S], S Scalar](a, b V) BVec4 {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return BVec4{va.X < vb.X, va.Y < vb.Y, va.Z < vb.Z, va.W < vb.W}
+}
+
+// LessThanEqual2 reports for each component whether a is less than or equal to b.
+func LessThanEqual2[V Vec2like[S], S Scalar](a, b V) BVec2 {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return BVec2{va.X <= vb.X, va.Y <= vb.Y}
+}
+
+// LessThanEqual3 reports for each component whether a is less than or equal to b.
+func LessThanEqual3[V Vec3like[S], S Scalar](a, b V) BVec3 {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return BVec3{va.X <= vb.X, va.Y <= vb.Y, va.Z <= vb.Z}
+}
+
+// LessThanEqual4 reports for each component whether a is less than or equal to b.
+func LessThanEqual4[V Vec4like[S], S Scalar](a, b V) BVec4 {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return BVec4{va.X <= vb.X, va.Y <= vb.Y, va.Z <= vb.Z, va.W <= vb.W}
+}
+
+// GreaterThan2 reports for each component whether a is greater than b.
+func GreaterThan2[V Vec2like[S], S Scalar](a, b V) BVec2 {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return BVec2{va.X > vb.X, va.Y > vb.Y}
+}
+
+// GreaterThan3 reports for each component whether a is greater than b.
+func GreaterThan3[V Vec3like[S], S Scalar](a, b V) BVec3 {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return BVec3{va.X > vb.X, va.Y > vb.Y, va.Z > vb.Z}
+}
+
+// GreaterThan4 reports for each component whether a is greater than b.
+func GreaterThan4[V Vec4like[S], S Scalar](a, b V) BVec4 {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return BVec4{va.X > vb.X, va.Y > vb.Y, va.Z > vb.Z, va.W > vb.W}
+}
+
+// GreaterThanEqual2 reports for each component whether a is greater than or equal to b.
+func GreaterThanEqual2[V Vec2like[S], S Scalar](a, b V) BVec2 {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return BVec2{va.X >= vb.X, va.Y >= vb.Y}
+}
+
+// GreaterThanEqual3 reports for each component whether a is greater than or equal to b.
+func GreaterThanEqual3[V Vec3like[S], S Scalar](a, b V) BVec3 {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return BVec3{va.X >= vb.X, va.Y >= vb.Y, va.Z >= vb.Z}
+}
+
+// GreaterThanEqual4 reports for each component whether a is greater than or equal to b.
+func GreaterThanEqual4[V Vec4like[S], S Scalar](a, b V) BVec4 {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return BVec4{va.X >= vb.X, va.Y >= vb.Y, va.Z >= vb.Z, va.W >= vb.W}
+}
+
+// Equal2 reports for each component whether a is equal to b.
+func Equal2[V Vec2like[S], S Scalar](a, b V) BVec2 {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return BVec2{va.X == vb.X, va.Y == vb.Y}
+}
+
+// Equal3 reports for each component whether a is equal to b.
+func Equal3[V Vec3like[S], S Scalar](a, b V) BVec3 {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return BVec3{va.X == vb.X, va.Y == vb.Y, va.Z == vb.Z}
+}
+
+// Equal4 reports for each component whether a is equal to b.
+func Equal4[V Vec4like[S], S Scalar](a, b V) BVec4 {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return BVec4{va.X == vb.X, va.Y == vb.Y, va.Z == vb.Z, va.W == vb.W}
+}
+
+// NotEqual2 reports for each component whether a is not equal to b.
+func NotEqual2[V Vec2like[S], S Scalar](a, b V) BVec2 {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return BVec2{va.X != vb.X, va.Y != vb.Y}
+}
+
+// NotEqual3 reports for each component whether a is not equal to b.
+func NotEqual3[V Vec3like[S], S Scalar](a, b V) BVec3 {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return BVec3{va.X != vb.X, va.Y != vb.Y, va.Z != vb.Z}
+}
+
+// NotEqual4 reports for each component whether a is not equal to b.
+func NotEqual4[V Vec4like[S], S Scalar](a, b V) BVec4 {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return BVec4{va.X != vb.X, va.Y != vb.Y, va.Z != vb.Z, va.W != vb.W}
+}
+
+// Select2 returns a vector taking each component from a where m is true
+// and from b where it is false.
+func Select2[V Vec2like[S], S Scalar](m BVec2, a, b V) V {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	if !m.X {
+		va.X = vb.X
+	}
+	if !m.Y {
+		va.Y = vb.Y
+	}
+	return V(va)
+}
+
+// Select3 returns a vector taking each component from a where m is true
+// and from b where it is false.
+func Select3[V Vec3like[S], S Scalar](m BVec3, a, b V) V {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	if !m.X {
+		va.X = vb.X
+	}
+	if !m.Y {
+		va.Y = vb.Y
+	}
+	if !m.Z {
+		va.Z = vb.Z
+	}
+	return V(va)
+}
+
+// Select4 returns a vector taking each component from a where m is true
+// and from b where it is false.
+func Select4[V Vec4like[S], S Scalar](m BVec4, a, b V) V {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	if !m.X {
+		va.X = vb.X
+	}
+	if !m.Y {
+		va.Y = vb.Y
+	}
+	if !m.Z {
+		va.Z = vb.Z
+	}
+	if !m.W {
+		va.W = vb.W
+	}
+	return V(va)
+}
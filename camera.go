@@ -0,0 +1,48 @@
+package vec
+
+// Camera2D maps a 2D world onto a screen viewport. The world point
+// Position appears at the center of Viewport, magnified by Zoom and turned
+// so that the world is rotated by -Rotation on screen.
+type Camera2D struct {
+	Position Vec2
+	Zoom     float64
+	Rotation float64
+	Viewport Rect
+}
+
+// Matrix returns the transform from world to screen coordinates.
+func (c Camera2D) Matrix() Affine2 {
+	center := Lerp2(c.Viewport.Min, c.Viewport.Max, 0.5)
+	return Translation2(center).
+		Mul(Scaling2(Splat2(c.Zoom))).
+		Mul(Rotation2(-c.Rotation)).
+		Mul(Translation2(c.Position.Neg()))
+}
+
+// SetGeoM sets g to the world to screen transform of c.
+func (c Camera2D) SetGeoM(g GeoM) { SetGeoM(g, c.Matrix()) }
+
+// WorldToScreen returns the screen position of the world point p.
+func (c Camera2D) WorldToScreen(p Vec2) Vec2 { return c.Matrix().Apply(p) }
+
+// ScreenToWorld returns the world point shown at the screen position p.
+// It returns Position if Zoom is zero.
+func (c Camera2D) ScreenToWorld(p Vec2) Vec2 {
+	inv, ok := c.Matrix().Inverse()
+	if !ok {
+		return c.Position
+	}
+	return inv.Apply(p)
+}
+
+// VisibleBounds returns the smallest axis-aligned world rectangle that
+// contains everything shown in the viewport.
+func (c Camera2D) VisibleBounds() Rect {
+	v := c.Viewport
+	corners := [4]Vec2{v.Min, {v.Max.X, v.Min.Y}, v.Max, {v.Min.X, v.Max.Y}}
+	var b Bounds2
+	for _, s := range corners {
+		b.Add(c.ScreenToWorld(s))
+	}
+	return b.Result()
+}
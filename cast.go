@@ -0,0 +1,239 @@
+package vec
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Sweep moves shape a by displacement and reports the first contact with
+// shape b. toi is the fraction of displacement travelled before contact, in
+// [0, 1], and normal is the unit contact normal pointing from b toward a.
+// If the shapes already overlap, it reports a hit with toi 0 and the normal
+// along which a should move to separate.
+//
+// The supported shapes are Circle, Rect, RoundedRect, Capsule2, Segment2
+// and Polygon; concave polygons are handled by splitting them into
+// triangles. Sweep panics on other shapes.
+func Sweep(a Shape2, displacement Vec2, b Shape2) (hit bool, toi float64, normal Vec2) {
+	toi = math.Inf(1)
+	for _, pa := range convexPieces(a) {
+		for _, pb := range convexPieces(b) {
+			if h, t, n := sweepPieces(pa, displacement, pb); h && t < toi {
+				hit, toi, normal = true, t, n
+			}
+		}
+	}
+	if !hit {
+		return false, 0, Vec2{}
+	}
+	return true, toi, normal
+}
+
+// ShapeCast moves shape by displacement through obstacles and reports the
+// earliest contact, as Sweep does for a single obstacle.
+// It is the building block for character movement: moving by toi times the
+// displacement brings shape into contact without passing through anything.
+func ShapeCast(shape Shape2, displacement Vec2, obstacles []Shape2) (hit bool, toi float64, normal Vec2) {
+	toi = math.Inf(1)
+	for _, o := range obstacles {
+		if h, t, n := Sweep(shape, displacement, o); h && t < toi {
+			hit, toi, normal = true, t, n
+		}
+	}
+	if !hit {
+		return false, 0, Vec2{}
+	}
+	return true, toi, normal
+}
+
+// RayHit2 is where a ray first meets a shape.
+type RayHit2 struct {
+	Index    int     // index of the shape in the slice passed to the query
+	Point    Vec2    // where the ray meets the shape
+	Normal   Vec2    // unit surface normal at Point, facing the ray
+	Distance float64 // distance from the ray origin to Point
+}
+
+// Raycast2 returns the nearest point at which ray r meets any of shapes.
+// A shape containing the origin is hit at distance 0, with the normal along
+// which the origin should move to leave it. The shapes must be supported
+// by Sweep. Returns false if r hits nothing.
+func Raycast2(r Ray2, shapes []Shape2) (RayHit2, bool) {
+	var best RayHit2
+	found := false
+	for i, s := range shapes {
+		if h, ok := raycastShape(r, s); ok && (!found || h.Distance < best.Distance) {
+			best, found = h, true
+			best.Index = i
+		}
+	}
+	return best, found
+}
+
+// RaycastAll2 returns the points at which ray r first meets each of shapes,
+// ordered by distance, as Raycast2 finds them.
+func RaycastAll2(r Ray2, shapes []Shape2) []RayHit2 {
+	var hits []RayHit2
+	for i, s := range shapes {
+		if h, ok := raycastShape(r, s); ok {
+			h.Index = i
+			hits = append(hits, h)
+		}
+	}
+	slices.SortStableFunc(hits, func(a, b RayHit2) int { return cmp.Compare(a.Distance, b.Distance) })
+	return hits
+}
+
+// raycastShape casts r against the convex pieces of s.
+func raycastShape(r Ray2, s Shape2) (RayHit2, bool) {
+	l := Len2(r.Dir)
+	best, found := math.Inf(1), false
+	var normal Vec2
+	for _, p := range convexPieces(s) {
+		if h, t, n := rayRoundedConvex(r.Dir, math.Inf(1), p.points.Translate(r.Origin.Neg()), p.radius); h && t < best {
+			best, normal, found = t, n, true
+		}
+	}
+	if !found {
+		return RayHit2{}, false
+	}
+	return RayHit2{Point: r.Origin.Add(r.Dir.Scale(best)), Normal: normal, Distance: best * l}, true
+}
+
+// convexPiece is a convex region: the convex hull of points, inflated by radius.
+type convexPiece struct {
+	points Polygon // counter-clockwise
+	radius float64
+}
+
+// convexPieces splits s into convex pieces whose union is s.
+func convexPieces(s Shape2) []convexPiece {
+	switch s := s.(type) {
+	case Circle:
+		return []convexPiece{{Polygon{s.Center}, s.Radius}}
+	case Rect:
+		return []convexPiece{{Polygon{s.Min, {s.Max.X, s.Min.Y}, s.Max, {s.Min.X, s.Max.Y}}, 0}}
+	case RoundedRect:
+		return []convexPiece{s.piece()}
+	case Capsule2:
+		return []convexPiece{s.piece()}
+	case Segment2:
+		return []convexPiece{newRoundedPiece(Polygon{s.A, s.B}, 0)}
+	case Polygon:
+		if s.IsConvex() {
+			if s.SignedArea() < 0 {
+				s = reversed(s)
+			}
+			return []convexPiece{{s, 0}}
+		}
+		var pieces []convexPiece
+		for _, t := range s.Triangulate() {
+			pieces = append(pieces, convexPiece{Polygon{s[t[0]], s[t[1]], s[t[2]]}, 0})
+		}
+		return pieces
+	}
+	panic("vec: unsupported shape type for sweep")
+}
+
+func reversed(p Polygon) Polygon {
+	r := make(Polygon, len(p))
+	for i, v := range p {
+		r[len(p)-1-i] = v
+	}
+	return r
+}
+
+// sweepPieces casts a along d against b. A translated by v overlaps B exactly
+// when v lies in the Minkowski difference B - A, so this is a ray cast from
+// the origin against that rounded convex region.
+func sweepPieces(a convexPiece, d Vec2, b convexPiece) (bool, float64, Vec2) {
+	diff := make([]Vec2, 0, len(a.points)*len(b.points))
+	for _, pb := range b.points {
+		for _, pa := range a.points {
+			diff = append(diff, pb.Sub(pa))
+		}
+	}
+	return rayRoundedConvex(d, 1, ConvexHull2(diff), a.radius+b.radius)
+}
+
+// rayRoundedConvex casts a ray from the origin along d, up to maxT times d,
+// against the convex polygon core inflated by r.
+func rayRoundedConvex(d Vec2, maxT float64, core Polygon, r float64) (bool, float64, Vec2) {
+	if dist, n := roundedConvexDistance(core, r); dist < 0 {
+		return true, 0, n
+	}
+
+	best, normal := math.Inf(1), Vec2{}
+	for i, a := range core {
+		if len(core) >= 2 {
+			b := core[(i+1)%len(core)]
+			e := b.Sub(a)
+			n := edgeNormal(e)
+			if denom := Dot2(d, n); denom < 0 {
+				off := a.Add(n.Scale(r))
+				t := Dot2(off, n) / denom
+				s := Dot2(d.Scale(t).Sub(off), e) / LenSq2(e)
+				if t >= 0 && t <= maxT && s >= 0 && s <= 1 && t < best {
+					best, normal = t, n
+				}
+			}
+		}
+		if r > 0 {
+			if t, _, k := circleLineParams(Circle{a, r}, Vec2{}, d); k > 0 && t >= 0 && t <= maxT && t < best {
+				best, normal = t, Normalize2(d.Scale(t).Sub(a))
+			}
+		}
+	}
+	if math.IsInf(best, 1) {
+		return false, 0, Vec2{}
+	}
+	return true, best, normal
+}
+
+// roundedConvexDistance returns the signed distance from the origin to the
+// convex polygon core inflated by r, and the outward normal of the nearest
+// boundary point.
+func roundedConvexDistance(core Polygon, r float64) (float64, Vec2) {
+	if len(core) >= 3 {
+		// Inside the core, the nearest boundary is the least penetrated edge.
+		inside, maxD, maxN := true, math.Inf(-1), Vec2{}
+		for i, a := range core {
+			e := core[(i+1)%len(core)].Sub(a)
+			n := edgeNormal(e)
+			if d := -Dot2(a, n); d > 0 {
+				inside = false
+			} else if d > maxD {
+				maxD, maxN = d, n
+			}
+		}
+		if inside {
+			return maxD - r, maxN
+		}
+	}
+	best, bestP := math.Inf(1), Vec2{}
+	for i, a := range core {
+		p := closestOnSegment(Vec2{}, a, core[(i+1)%len(core)])
+		if d := Len2(p); d < best {
+			best, bestP = d, p
+		}
+	}
+	return best - r, Normalize2(bestP.Neg())
+}
+
+// edgeNormal returns the outward unit normal of the edge direction e of a
+// counter-clockwise polygon.
+func edgeNormal(e Vec2) Vec2 {
+	return Normalize2(Vec2{e.Y, 0 - e.X}) // 0 - x avoids a negative zero
+}
+
+// closestOnSegment returns the point on segment ab closest to p.
+func closestOnSegment(p, a, b Vec2) Vec2 {
+	e := b.Sub(a)
+	l := LenSq2(e)
+	if l == 0 {
+		return a
+	}
+	t := min(max(Dot2(p.Sub(a), e)/l, 0), 1)
+	return a.Add(e.Scale(t))
+}
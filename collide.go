@@ -0,0 +1,235 @@
+package vec
+
+import "math"
+
+// Contact2 describes how two overlapping shapes touch.
+type Contact2 struct {
+	// Point is a point midway between the surfaces where they overlap most.
+	Point Vec2
+	// Normal is the unit direction from the first shape toward the second.
+	// Moving the second shape by Normal*Depth separates them.
+	Normal Vec2
+	// Depth is how far the shapes overlap along Normal.
+	Depth float64
+}
+
+// Collide reports whether shapes a and b overlap or touch, and if so how.
+// The shapes must be supported by Sweep; concave polygons are split into
+// triangles and the deepest contact between the pieces is reported.
+func Collide(a, b Shape2) (Contact2, bool) {
+	var best Contact2
+	found := false
+	for _, pa := range convexPieces(a) {
+		for _, pb := range convexPieces(b) {
+			if c, ok := collidePieces(pa, pb); ok && (!found || c.Depth > best.Depth) {
+				best, found = c, true
+			}
+		}
+	}
+	return best, found
+}
+
+// collidePieces finds the contact between a and b from the point of their
+// Minkowski difference nearest to the origin, as in sweepPieces.
+func collidePieces(a, b convexPiece) (Contact2, bool) {
+	diff := make([]Vec2, 0, len(a.points)*len(b.points))
+	for _, pb := range b.points {
+		for _, pa := range a.points {
+			diff = append(diff, pb.Sub(pa))
+		}
+	}
+	dist, n := roundedConvexDistance(ConvexHull2(diff), a.radius+b.radius)
+	if dist > 0 {
+		return Contact2{}, false
+	}
+	normal := Vec2{}.Sub(n) // rather than Neg, to avoid negative zeros
+	if normal == (Vec2{}) {
+		normal = Vec2{1, 0} // coincident centers
+	}
+	depth := -dist
+	return Contact2{contactPoint(a, b, normal, depth), normal, depth}, true
+}
+
+// contactPoint returns the point midway between the surfaces of a and b,
+// which overlap by depth along normal, where they overlap most.
+func contactPoint(a, b convexPiece, normal Vec2, depth float64) Vec2 {
+	fa, fb := a.support(normal), b.support(normal.Neg())
+	switch {
+	case len(fa) == 1:
+		return fa[0].Sub(normal.Scale(depth / 2))
+	case len(fb) == 1:
+		return fb[0].Add(normal.Scale(depth / 2))
+	}
+	// Two edges face each other: take the middle of their overlap.
+	tangent := Vec2{-normal.Y, normal.X}
+	alo, ahi := projectPoints(fa, tangent)
+	blo, bhi := projectPoints(fb, tangent)
+	mid := (max(alo, blo) + min(ahi, bhi)) / 2
+	along := (Dot2(fa[0], normal) + Dot2(fb[0], normal)) / 2
+	return tangent.Scale(mid).Add(normal.Scale(along))
+}
+
+// support returns the points of c farthest along the unit direction d: one
+// for a vertex or two for an edge perpendicular to d.
+func (c convexPiece) support(d Vec2) []Vec2 {
+	_, hi := projectPoints(c.points, d)
+	tol := 1e-9 * max(1, math.Abs(hi))
+	var ps []Vec2
+	for _, p := range c.points {
+		if Dot2(p, d) >= hi-tol {
+			ps = append(ps, p.Add(d.Scale(c.radius)))
+		}
+	}
+	return ps
+}
+
+// Manifold2 is the set of contact points between two overlapping convex
+// shapes, as a physics solver needs to resolve the collision without the
+// shapes rocking on a single point.
+type Manifold2 struct {
+	// Normal is the unit direction from the first shape toward the second.
+	Normal Vec2
+	// Points are the contact points, midway between the surfaces, and
+	// Depths how far the shapes overlap along Normal at each of them.
+	// Only the first N entries are used.
+	Points [2]Vec2
+	Depths [2]float64
+	N      int
+}
+
+// MaxDepth returns the deepest penetration in m.
+func (m Manifold2) MaxDepth() float64 {
+	d := 0.0
+	for _, x := range m.Depths[:m.N] {
+		d = max(d, x)
+	}
+	return d
+}
+
+// CirclePolygonManifold returns the contact between circle c and the convex
+// polygon p, which has a single point. Returns false if they do not touch.
+func CirclePolygonManifold(c Circle, p Polygon) (Manifold2, bool) {
+	if len(p) == 0 {
+		return Manifold2{}, false
+	}
+	if p.SignedArea() < 0 {
+		p = reversed(p)
+	}
+	q := p.ClosestPoint(c.Center)
+	var n Vec2 // outward from p toward the center of c
+	var dist float64
+	if len(p) >= 3 && q == c.Center {
+		// The center is inside: push out through the least penetrated edge.
+		i, s := maxSeparation(p, []Vec2{c.Center})
+		n, dist = edgeNormal(p[(i+1)%len(p)].Sub(p[i])), s
+		q = c.Center.Sub(n.Scale(s))
+	} else {
+		d := c.Center.Sub(q)
+		dist = Len2(d)
+		n = d.Divs(dist)
+	}
+	if dist > c.Radius {
+		return Manifold2{}, false
+	}
+	surface := c.Center.Sub(n.Scale(c.Radius))
+	m := Manifold2{Normal: Vec2{}.Sub(n), N: 1}
+	m.Points[0], m.Depths[0] = Lerp2(q, surface, 0.5), c.Radius-dist
+	return m, true
+}
+
+// PolygonManifold returns the contact between the convex polygons a and b,
+// with two points when edges rest against each other. It finds the axis of
+// least penetration among the edge normals and clips the edge of the other
+// polygon facing it against that reference edge. Returns false if they do
+// not touch.
+func PolygonManifold(a, b Polygon) (Manifold2, bool) {
+	if len(a) < 3 || len(b) < 3 {
+		return Manifold2{}, false
+	}
+	if a.SignedArea() < 0 {
+		a = reversed(a)
+	}
+	if b.SignedArea() < 0 {
+		b = reversed(b)
+	}
+	ia, sa := maxSeparation(a, b)
+	if sa > 0 {
+		return Manifold2{}, false
+	}
+	ib, sb := maxSeparation(b, a)
+	if sb > 0 {
+		return Manifold2{}, false
+	}
+
+	// Prefer a as the reference unless b separates clearly better, so that
+	// nearly equal axes do not flip between frames.
+	ref, inc, i, flip := a, b, ia, false
+	if sb > sa+1e-9*max(1, math.Abs(sa)) {
+		ref, inc, i, flip = b, a, ib, true
+	}
+	v1, v2 := ref[i], ref[(i+1)%len(ref)]
+	tangent := Normalize2(v2.Sub(v1))
+	n := edgeNormal(v2.Sub(v1))
+
+	// The incident edge is the one of inc most opposed to n.
+	j, least := 0, math.Inf(1)
+	for k, v := range inc {
+		if d := Dot2(edgeNormal(inc[(k+1)%len(inc)].Sub(v)), n); d < least {
+			j, least = k, d
+		}
+	}
+	seg := [2]Vec2{inc[j], inc[(j+1)%len(inc)]}
+	seg, ok := clipSegment(seg, tangent.Neg(), -Dot2(tangent, v1))
+	if ok {
+		seg, ok = clipSegment(seg, tangent, Dot2(tangent, v2))
+	}
+	if !ok {
+		return Manifold2{}, false
+	}
+
+	m := Manifold2{Normal: n}
+	if flip {
+		m.Normal = Vec2{}.Sub(n)
+	}
+	for _, p := range seg {
+		if sep := Dot2(p.Sub(v1), n); sep <= 0 {
+			m.Points[m.N], m.Depths[m.N] = p.Sub(n.Scale(sep/2)), -sep
+			m.N++
+		}
+	}
+	return m, m.N > 0
+}
+
+// maxSeparation returns the edge of the counter-clockwise polygon p that
+// has the deepest of points farthest in front of it, and the signed distance
+// of that point from the edge. It is positive if the edge separates points
+// from p.
+func maxSeparation(p Polygon, points []Vec2) (edge int, sep float64) {
+	sep = math.Inf(-1)
+	for i, v := range p {
+		n := edgeNormal(p[(i+1)%len(p)].Sub(v))
+		s := math.Inf(1)
+		for _, q := range points {
+			s = min(s, Dot2(q.Sub(v), n))
+		}
+		if s > sep {
+			edge, sep = i, s
+		}
+	}
+	return edge, sep
+}
+
+// clipSegment keeps the part of seg where Dot2(p, n) <= offset.
+// Returns false if nothing is left.
+func clipSegment(seg [2]Vec2, n Vec2, offset float64) ([2]Vec2, bool) {
+	d0, d1 := Dot2(seg[0], n)-offset, Dot2(seg[1], n)-offset
+	switch {
+	case d0 > 0 && d1 > 0:
+		return seg, false
+	case d0 > 0:
+		seg[0] = Lerp2(seg[0], seg[1], d0/(d0-d1))
+	case d1 > 0:
+		seg[1] = Lerp2(seg[0], seg[1], d0/(d0-d1))
+	}
+	return seg, true
+}
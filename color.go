@@ -0,0 +1,177 @@
+package vec
+
+import (
+	"image/color"
+	"math"
+)
+
+// Colors are represented as Vec3 (RGB) or Vec4 (RGBA) with components in
+// [0, 1]. RGB values are sRGB encoded unless stated otherwise, and alpha is
+// not premultiplied. Hues are in degrees in [0, 360).
+
+// FromColor returns c as non-premultiplied RGBA in [0, 1].
+func FromColor(c color.Color) Vec4 {
+	n := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	return Vec4{float64(n.R), float64(n.G), float64(n.B), float64(n.A)}.Divs(0xffff)
+}
+
+// ToColor returns v, non-premultiplied RGBA in [0, 1], as a color.NRGBA64.
+// Components outside [0, 1] are clamped.
+func ToColor(v Vec4) color.NRGBA64 {
+	q := func(c float64) uint16 { return uint16(math.Round(min(max(c, 0), 1) * 0xffff)) }
+	return color.NRGBA64{q(v.X), q(v.Y), q(v.Z), q(v.W)}
+}
+
+// SRGBToLinear converts an sRGB encoded component to linear light.
+func SRGBToLinear(c float64) float64 {
+	if math.Abs(c) <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Copysign(math.Pow((math.Abs(c)+0.055)/1.055, 2.4), c)
+}
+
+// LinearToSRGB converts a linear light component to sRGB encoding.
+func LinearToSRGB(c float64) float64 {
+	if math.Abs(c) <= 0.0031308 {
+		return c * 12.92
+	}
+	return math.Copysign(1.055*math.Pow(math.Abs(c), 1/2.4)-0.055, c)
+}
+
+// RGBToHSV converts rgb to hue, saturation and value.
+func RGBToHSV(rgb Vec3) Vec3 {
+	hi := max(rgb.X, rgb.Y, rgb.Z)
+	lo := min(rgb.X, rgb.Y, rgb.Z)
+	var s float64
+	if hi > 0 {
+		s = (hi - lo) / hi
+	}
+	return Vec3{hue(rgb, hi, lo), s, hi}
+}
+
+// HSVToRGB converts hue, saturation and value to RGB.
+func HSVToRGB(hsv Vec3) Vec3 {
+	c := hsv.Z * hsv.Y
+	return hueToRGB(hsv.X, c).Adds(hsv.Z - c)
+}
+
+// RGBToHSL converts rgb to hue, saturation and lightness.
+func RGBToHSL(rgb Vec3) Vec3 {
+	hi := max(rgb.X, rgb.Y, rgb.Z)
+	lo := min(rgb.X, rgb.Y, rgb.Z)
+	l := (hi + lo) / 2
+	var s float64
+	if d := 1 - math.Abs(2*l-1); d > 0 {
+		s = (hi - lo) / d
+	}
+	return Vec3{hue(rgb, hi, lo), s, l}
+}
+
+// HSLToRGB converts hue, saturation and lightness to RGB.
+func HSLToRGB(hsl Vec3) Vec3 {
+	c := (1 - math.Abs(2*hsl.Z-1)) * hsl.Y
+	return hueToRGB(hsl.X, c).Adds(hsl.Z - c/2)
+}
+
+// hue returns the hue of rgb in degrees given its largest and smallest
+// components, or 0 for grays.
+func hue(rgb Vec3, hi, lo float64) float64 {
+	d := hi - lo
+	if d == 0 {
+		return 0
+	}
+	var h float64
+	switch hi {
+	case rgb.X:
+		h = (rgb.Y - rgb.Z) / d
+	case rgb.Y:
+		h = (rgb.Z-rgb.X)/d + 2
+	default:
+		h = (rgb.X-rgb.Y)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// hueToRGB returns the fully saturated color of hue h with chroma c and
+// its smallest component at zero.
+func hueToRGB(h, c float64) Vec3 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	h /= 60
+	x := c * (1 - math.Abs(math.Mod(h, 2)-1))
+	switch int(h) {
+	case 0:
+		return Vec3{c, x, 0}
+	case 1:
+		return Vec3{x, c, 0}
+	case 2:
+		return Vec3{0, c, x}
+	case 3:
+		return Vec3{0, x, c}
+	case 4:
+		return Vec3{x, 0, c}
+	default:
+		return Vec3{c, 0, x}
+	}
+}
+
+// RGBToOKLab converts sRGB encoded rgb to OKLab (L, a, b).
+func RGBToOKLab(rgb Vec3) Vec3 {
+	r, g, b := SRGBToLinear(rgb.X), SRGBToLinear(rgb.Y), SRGBToLinear(rgb.Z)
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+	return Vec3{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+// OKLabToRGB converts OKLab (L, a, b) to sRGB encoded RGB.
+// Colors outside the sRGB gamut yield components outside [0, 1].
+func OKLabToRGB(lab Vec3) Vec3 {
+	l := lab.X + 0.3963377774*lab.Y + 0.2158037573*lab.Z
+	m := lab.X - 0.1055613458*lab.Y - 0.0638541728*lab.Z
+	s := lab.X - 0.0894841775*lab.Y - 1.2914855480*lab.Z
+	l, m, s = l*l*l, m*m*m, s*s*s
+	return Vec3{
+		LinearToSRGB(+4.0767416621*l - 3.3077115913*m + 0.2309699292*s),
+		LinearToSRGB(-1.2684380046*l + 2.6097574011*m - 0.3413193965*s),
+		LinearToSRGB(-0.0041960863*l - 0.7034186147*m + 1.7076147010*s),
+	}
+}
+
+// OKLabToOKLCH converts OKLab (L, a, b) to its polar form (L, chroma, hue).
+func OKLabToOKLCH(lab Vec3) Vec3 {
+	h := math.Atan2(lab.Z, lab.Y) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return Vec3{lab.X, math.Hypot(lab.Y, lab.Z), h}
+}
+
+// OKLCHToOKLab converts OKLCH (L, chroma, hue) to OKLab (L, a, b).
+func OKLCHToOKLab(lch Vec3) Vec3 {
+	sin, cos := math.Sincos(lch.Z * math.Pi / 180)
+	return Vec3{lch.X, lch.Y * cos, lch.Y * sin}
+}
+
+// RGBToOKLCH converts sRGB encoded rgb to OKLCH (L, chroma, hue).
+func RGBToOKLCH(rgb Vec3) Vec3 { return OKLabToOKLCH(RGBToOKLab(rgb)) }
+
+// OKLCHToRGB converts OKLCH (L, chroma, hue) to sRGB encoded RGB.
+func OKLCHToRGB(lch Vec3) Vec3 { return OKLabToRGB(OKLCHToOKLab(lch)) }
+
+// MixOKLab interpolates between the sRGBA colors a and b in OKLab, which
+// gives perceptually even gradients. Alpha is interpolated linearly.
+func MixOKLab(a, b Vec4, t float64) Vec4 {
+	lab := Lerp3(RGBToOKLab(a.Vec3()), RGBToOKLab(b.Vec3()), t)
+	return OKLabToRGB(lab).Vec4(a.W + (b.W-a.W)*t)
+}
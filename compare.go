@@ -0,0 +1,81 @@
+package vec
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Compare2 compares a and b lexicographically by X, then Y.
+// It returns -1, 0 or +1 as cmp.Compare does, so it can be passed to slices.SortFunc.
+func Compare2[V Vec2like[S], S Scalar](a, b V) int {
+	va := Vec2g[S](a)
+	vb := Vec2g[S](b)
+	if c := cmp.Compare(va.X, vb.X); c != 0 {
+		return c
+	}
+	return cmp.Compare(va.Y, vb.Y)
+}
+
+// Compare3 compares a and b lexicographically by X, Y, then Z.
+// It returns -1, 0 or +1 as cmp.Compare does, so it can be passed to slices.SortFunc.
+func Compare3[V Vec3like[S], S Scalar](a, b V) int {
+	va := Vec3g[S](a)
+	vb := Vec3g[S](b)
+	if c := cmp.Compare(va.X, vb.X); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(va.Y, vb.Y); c != 0 {
+		return c
+	}
+	return cmp.Compare(va.Z, vb.Z)
+}
+
+// Compare4 compares a and b lexicographically by X, Y, Z, then W.
+// It returns -1, 0 or +1 as cmp.Compare does, so it can be passed to slices.SortFunc.
+func Compare4[V Vec4like[S], S Scalar](a, b V) int {
+	va := Vec4g[S](a)
+	vb := Vec4g[S](b)
+	if c := cmp.Compare(va.X, vb.X); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(va.Y, vb.Y); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(va.Z, vb.Z); c != 0 {
+		return c
+	}
+	return cmp.Compare(va.W, vb.W)
+}
+
+// SortByAngle sorts points in place by their counter-clockwise angle around
+// the given center, starting from the +X direction. Points at the same angle
+// are ordered by distance, and points equal to around come first.
+// The comparison uses cross products rather than trigonometry, so it is
+// exact for signed integer vectors. Unsigned vectors are not supported,
+// since the offsets from around can be negative.
+func SortByAngle[V Vec2like[S], S Signed | Float](points []V, around V) {
+	c := Vec2g[S](around)
+	// half classifies a direction: 0 for the center itself, 1 for angles in
+	// [0, π), 2 for angles in [π, 2π).
+	half := func(d Vec2g[S]) int {
+		switch {
+		case d.X == 0 && d.Y == 0:
+			return 0
+		case d.Y > 0 || (d.Y == 0 && d.X > 0):
+			return 1
+		}
+		return 2
+	}
+	slices.SortFunc(points, func(a, b V) int {
+		da := Vec2g[S](a).Sub(c)
+		db := Vec2g[S](b).Sub(c)
+		if h := cmp.Compare(half(da), half(db)); h != 0 {
+			return h
+		}
+		// a comes first if b is counter-clockwise from it (positive cross product).
+		if c := cmp.Compare(da.Y*db.X, da.X*db.Y); c != 0 {
+			return c
+		}
+		return cmp.Compare(LenSq2(da), LenSq2(db))
+	})
+}
@@ -0,0 +1,17 @@
+package vec
+
+// ToComplex returns v as the complex number x+yi.
+func ToComplex[V Vec2like[S], S Scalar](v V) complex128 {
+	vv := Vec2g[S](v)
+	return complex(float64(vv.X), float64(vv.Y))
+}
+
+// FromComplex returns c as the vector (real(c), imag(c)).
+func FromComplex(c complex128) Vec2 { return Vec2{real(c), imag(c)} }
+
+// MulComplex returns the complex product of a and b. When b has unit
+// length this rotates a by the angle of b, and chains of rotations compose
+// by multiplication.
+func MulComplex(a, b Vec2) Vec2 {
+	return Vec2{a.X*b.X - a.Y*b.Y, a.X*b.Y + a.Y*b.X}
+}
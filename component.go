@@ -0,0 +1,216 @@
+package vec
+
+import (
+	"iter"
+	"slices"
+)
+
+// At returns the i-th component of a: X for 0 and Y for 1.
+// It panics if i is out of range.
+func (a Vec2g[S]) At(i int) S {
+	switch i {
+	case 0:
+		return a.X
+	case 1:
+		return a.Y
+	}
+	panic("vec: component index out of range")
+}
+
+// At returns the i-th component of a: X, Y, Z for 0, 1, 2.
+// It panics if i is out of range.
+func (a Vec3g[S]) At(i int) S {
+	switch i {
+	case 0:
+		return a.X
+	case 1:
+		return a.Y
+	case 2:
+		return a.Z
+	}
+	panic("vec: component index out of range")
+}
+
+// At returns the i-th component of a: X, Y, Z, W for 0, 1, 2, 3.
+// It panics if i is out of range.
+func (a Vec4g[S]) At(i int) S {
+	switch i {
+	case 0:
+		return a.X
+	case 1:
+		return a.Y
+	case 2:
+		return a.Z
+	case 3:
+		return a.W
+	}
+	panic("vec: component index out of range")
+}
+
+// SetAt sets the i-th component of a. It panics if i is out of range.
+func (a *Vec2g[S]) SetAt(i int, v S) {
+	switch i {
+	case 0:
+		a.X = v
+	case 1:
+		a.Y = v
+	default:
+		panic("vec: component index out of range")
+	}
+}
+
+// SetAt sets the i-th component of a. It panics if i is out of range.
+func (a *Vec3g[S]) SetAt(i int, v S) {
+	switch i {
+	case 0:
+		a.X = v
+	case 1:
+		a.Y = v
+	case 2:
+		a.Z = v
+	default:
+		panic("vec: component index out of range")
+	}
+}
+
+// SetAt sets the i-th component of a. It panics if i is out of range.
+func (a *Vec4g[S]) SetAt(i int, v S) {
+	switch i {
+	case 0:
+		a.X = v
+	case 1:
+		a.Y = v
+	case 2:
+		a.Z = v
+	case 3:
+		a.W = v
+	default:
+		panic("vec: component index out of range")
+	}
+}
+
+// WithAt returns a copy of a with the i-th component set to v.
+// It panics if i is out of range.
+func (a Vec2g[S]) WithAt(i int, v S) Vec2g[S] { a.SetAt(i, v); return a }
+
+// WithAt returns a copy of a with the i-th component set to v.
+// It panics if i is out of range.
+func (a Vec3g[S]) WithAt(i int, v S) Vec3g[S] { a.SetAt(i, v); return a }
+
+// WithAt returns a copy of a with the i-th component set to v.
+// It panics if i is out of range.
+func (a Vec4g[S]) WithAt(i int, v S) Vec4g[S] { a.SetAt(i, v); return a }
+
+// WithX returns a copy of a with X set to x.
+func (a Vec2g[S]) WithX(x S) Vec2g[S] { return Vec2g[S]{x, a.Y} }
+
+// WithY returns a copy of a with Y set to y.
+func (a Vec2g[S]) WithY(y S) Vec2g[S] { return Vec2g[S]{a.X, y} }
+
+// WithX returns a copy of a with X set to x.
+func (a Vec3g[S]) WithX(x S) Vec3g[S] { return Vec3g[S]{x, a.Y, a.Z} }
+
+// WithY returns a copy of a with Y set to y.
+func (a Vec3g[S]) WithY(y S) Vec3g[S] { return Vec3g[S]{a.X, y, a.Z} }
+
+// WithZ returns a copy of a with Z set to z.
+func (a Vec3g[S]) WithZ(z S) Vec3g[S] { return Vec3g[S]{a.X, a.Y, z} }
+
+// WithXY returns a copy of a with X and Y set to x and y.
+func (a Vec3g[S]) WithXY(x, y S) Vec3g[S] { return Vec3g[S]{x, y, a.Z} }
+
+// WithX returns a copy of a with X set to x.
+func (a Vec4g[S]) WithX(x S) Vec4g[S] { return Vec4g[S]{x, a.Y, a.Z, a.W} }
+
+// WithY returns a copy of a with Y set to y.
+func (a Vec4g[S]) WithY(y S) Vec4g[S] { return Vec4g[S]{a.X, y, a.Z, a.W} }
+
+// WithZ returns a copy of a with Z set to z.
+func (a Vec4g[S]) WithZ(z S) Vec4g[S] { return Vec4g[S]{a.X, a.Y, z, a.W} }
+
+// WithW returns a copy of a with W set to w.
+func (a Vec4g[S]) WithW(w S) Vec4g[S] { return Vec4g[S]{a.X, a.Y, a.Z, w} }
+
+// WithXY returns a copy of a with X and Y set to x and y.
+func (a Vec4g[S]) WithXY(x, y S) Vec4g[S] { return Vec4g[S]{x, y, a.Z, a.W} }
+
+// WithXYZ returns a copy of a with X, Y and Z set to x, y and z.
+func (a Vec4g[S]) WithXYZ(x, y, z S) Vec4g[S] { return Vec4g[S]{x, y, z, a.W} }
+
+// Components returns an iterator over the components of a in order.
+func (a Vec2g[S]) Components() iter.Seq[S] {
+	return func(yield func(S) bool) {
+		_ = yield(a.X) && yield(a.Y)
+	}
+}
+
+// Components returns an iterator over the components of a in order.
+func (a Vec3g[S]) Components() iter.Seq[S] {
+	return func(yield func(S) bool) {
+		_ = yield(a.X) && yield(a.Y) && yield(a.Z)
+	}
+}
+
+// Components returns an iterator over the components of a in order.
+func (a Vec4g[S]) Components() iter.Seq[S] {
+	return func(yield func(S) bool) {
+		_ = yield(a.X) && yield(a.Y) && yield(a.Z) && yield(a.W)
+	}
+}
+
+// All returns an iterator over the indices and components of a.
+func (a Vec2g[S]) All() iter.Seq2[int, S] {
+	return func(yield func(int, S) bool) {
+		_ = yield(0, a.X) && yield(1, a.Y)
+	}
+}
+
+// All returns an iterator over the indices and components of a.
+func (a Vec3g[S]) All() iter.Seq2[int, S] {
+	return func(yield func(int, S) bool) {
+		_ = yield(0, a.X) && yield(1, a.Y) && yield(2, a.Z)
+	}
+}
+
+// All returns an iterator over the indices and components of a.
+func (a Vec4g[S]) All() iter.Seq2[int, S] {
+	return func(yield func(int, S) bool) {
+		_ = yield(0, a.X) && yield(1, a.Y) && yield(2, a.Z) && yield(3, a.W)
+	}
+}
+
+// Components returns an iterator over the components of a in order.
+func (a VecN[S]) Components() iter.Seq[S] { return slices.Values(a) }
+
+// All returns an iterator over the indices and components of a.
+func (a VecN[S]) All() iter.Seq2[int, S] { return slices.All(a) }
+
+// RectPoints returns an iterator over the integer points in r, with Max
+// exclusive, in row-major order: X varies fastest, then Y.
+func RectPoints(r Recti) iter.Seq[Vec2i] {
+	return func(yield func(Vec2i) bool) {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				if !yield(Vec2i{x, y}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// BoxPoints returns an iterator over the integer points in b, with Max
+// exclusive, in X-major order: X varies fastest, then Y, then Z.
+func BoxPoints(b Boxi) iter.Seq[Vec3i] {
+	return func(yield func(Vec3i) bool) {
+		for z := b.Min.Z; z < b.Max.Z; z++ {
+			for y := b.Min.Y; y < b.Max.Y; y++ {
+				for x := b.Min.X; x < b.Max.X; x++ {
+					if !yield(Vec3i{x, y, z}) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package vec
+
+import (
+	"iter"
+	"math"
+)
+
+// RayMarchGrid returns an iterator over the cells of a grid of square
+// cells of side cellSize that the ray origin + dir*t, t >= 0, passes
+// through, in order, with the t at which the ray enters each cell. The
+// first cell, containing origin, has t = 0. Cell (i, j) covers
+// [i*cellSize, (i+1)*cellSize) on X and likewise on Y.
+//
+// The sequence is infinite unless dir is zero, so callers stop it by
+// breaking out of the loop, for example when t exceeds a maximum distance
+// or a solid cell is hit. It uses the traversal of Amanatides and Woo.
+func RayMarchGrid(origin, dir Vec2, cellSize float64) iter.Seq2[Vec2i, float64] {
+	return func(yield func(Vec2i, float64) bool) {
+		for c, t := range marchCells(2, [3]float64{origin.X, origin.Y}, [3]float64{dir.X, dir.Y}, cellSize) {
+			if !yield(Vec2i{c[0], c[1]}, t) {
+				return
+			}
+		}
+	}
+}
+
+// RayMarchVoxels is the 3D version of RayMarchGrid, visiting the cubic
+// voxels of side cellSize that the ray passes through.
+func RayMarchVoxels(origin, dir Vec3, cellSize float64) iter.Seq2[Vec3i, float64] {
+	return func(yield func(Vec3i, float64) bool) {
+		for c, t := range marchCells(3, [3]float64{origin.X, origin.Y, origin.Z}, [3]float64{dir.X, dir.Y, dir.Z}, cellSize) {
+			if !yield(Vec3i{c[0], c[1], c[2]}, t) {
+				return
+			}
+		}
+	}
+}
+
+// marchCells runs the grid traversal over the first n axes.
+func marchCells(n int, origin, dir [3]float64, cellSize float64) iter.Seq2[[3]int, float64] {
+	return func(yield func([3]int, float64) bool) {
+		var cell, step [3]int
+		var tMax, tDelta [3]float64
+		moving := false
+		for i := range n {
+			cell[i] = int(math.Floor(origin[i] / cellSize))
+			tMax[i], tDelta[i] = math.Inf(1), math.Inf(1)
+			switch {
+			case dir[i] > 0:
+				step[i] = 1
+				tMax[i] = (float64(cell[i]+1)*cellSize - origin[i]) / dir[i]
+			case dir[i] < 0:
+				step[i] = -1
+				tMax[i] = (float64(cell[i])*cellSize - origin[i]) / dir[i]
+			default:
+				continue
+			}
+			tDelta[i] = cellSize / math.Abs(dir[i])
+			moving = true
+		}
+
+		t := 0.0
+		for {
+			if !yield(cell, t) || !moving {
+				return
+			}
+			axis := 0
+			for i := 1; i < n; i++ {
+				if tMax[i] < tMax[axis] {
+					axis = i
+				}
+			}
+			t = tMax[axis]
+			cell[axis] += step[axis]
+			tMax[axis] += tDelta[axis]
+		}
+	}
+}
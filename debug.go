@@ -0,0 +1,131 @@
+package vec
+
+// DebugDraw receives debug geometry. Implement it on top of any renderer to
+// visualize shapes, contacts and paths computed by this package.
+type DebugDraw interface {
+	Line(a, b Vec2)
+	Circle(center Vec2, radius float64)
+	Rect(r Rect)
+	Arrow(from, to Vec2)
+	Text(at Vec2, s string)
+}
+
+// DebugKind identifies the primitive of a DebugCmd.
+type DebugKind int
+
+const (
+	DebugLine DebugKind = iota
+	DebugCircle
+	DebugRect
+	DebugArrow
+	DebugText
+)
+
+// DebugCmd is a single recorded DebugDraw call.
+// A and B are the endpoints of lines and arrows, the center of circles,
+// the corners of rects and the position of text.
+type DebugCmd struct {
+	Kind   DebugKind
+	A, B   Vec2
+	Radius float64
+	Text   string
+}
+
+// DebugRecorder is a DebugDraw that buffers calls for later replay.
+// The zero value is ready to use.
+type DebugRecorder struct {
+	Cmds []DebugCmd
+}
+
+// Line records a DebugLine command.
+func (r *DebugRecorder) Line(a, b Vec2) {
+	r.Cmds = append(r.Cmds, DebugCmd{Kind: DebugLine, A: a, B: b})
+}
+
+// Circle records a DebugCircle command.
+func (r *DebugRecorder) Circle(center Vec2, radius float64) {
+	r.Cmds = append(r.Cmds, DebugCmd{Kind: DebugCircle, A: center, Radius: radius})
+}
+
+// Rect records a DebugRect command.
+func (r *DebugRecorder) Rect(rect Rect) {
+	r.Cmds = append(r.Cmds, DebugCmd{Kind: DebugRect, A: rect.Min, B: rect.Max})
+}
+
+// Arrow records a DebugArrow command.
+func (r *DebugRecorder) Arrow(from, to Vec2) {
+	r.Cmds = append(r.Cmds, DebugCmd{Kind: DebugArrow, A: from, B: to})
+}
+
+// Text records a DebugText command.
+func (r *DebugRecorder) Text(at Vec2, s string) {
+	r.Cmds = append(r.Cmds, DebugCmd{Kind: DebugText, A: at, Text: s})
+}
+
+// Replay sends the recorded calls to d in order.
+func (r *DebugRecorder) Replay(d DebugDraw) {
+	for _, c := range r.Cmds {
+		switch c.Kind {
+		case DebugLine:
+			d.Line(c.A, c.B)
+		case DebugCircle:
+			d.Circle(c.A, c.Radius)
+		case DebugRect:
+			d.Rect(Rect{c.A, c.B})
+		case DebugArrow:
+			d.Arrow(c.A, c.B)
+		case DebugText:
+			d.Text(c.A, c.Text)
+		}
+	}
+}
+
+// Reset discards the recorded calls, keeping the buffer for reuse.
+func (r *DebugRecorder) Reset() { r.Cmds = r.Cmds[:0] }
+
+// DrawShape emits the outline of s. Circles and rects map to their
+// primitives, while polygons and segments become lines.
+// Other shapes are approximated by 32 points along their edge.
+func DrawShape(d DebugDraw, s Shape2) {
+	switch s := s.(type) {
+	case Circle:
+		d.Circle(s.Center, s.Radius)
+	case Rect:
+		d.Rect(s)
+	case Segment2:
+		d.Line(s.A, s.B)
+	case Polygon:
+		drawLoop(d, s)
+	default:
+		drawLoop(d, EdgePoints(s, 32))
+	}
+}
+
+// DrawOBB emits the outline of b.
+func DrawOBB(d DebugDraw, b OBB2) {
+	c := b.Corners()
+	drawLoop(d, c[:])
+}
+
+// DrawSlide emits the contact normals of a MoveAndSlide result as arrows of
+// length scale at its final position, and its velocity as an arrow.
+func DrawSlide(d DebugDraw, r SlideResult, scale float64) {
+	for _, n := range r.Normals {
+		d.Arrow(r.Pos, r.Pos.Add(n.Scale(scale)))
+	}
+	d.Arrow(r.Pos, r.Pos.Add(r.Vel))
+}
+
+// DrawDubins emits path as line segments at most step long.
+func DrawDubins(d DebugDraw, path DubinsPath, step float64) {
+	pts := path.Sample(step)
+	for i := 1; i < len(pts); i++ {
+		d.Line(pts[i-1], pts[i])
+	}
+}
+
+func drawLoop(d DebugDraw, pts []Vec2) {
+	for i := range pts {
+		d.Line(pts[i], pts[(i+1)%len(pts)])
+	}
+}
@@ -0,0 +1,68 @@
+package vec
+
+import "math"
+
+// Direction8 is one of the eight compass directions, numbered
+// counter-clockwise from East in steps of 45°. East is +X and North is +Y;
+// on screens where Y points down, North appears at the bottom.
+type Direction8 int
+
+const (
+	East Direction8 = iota
+	NorthEast
+	North
+	NorthWest
+	West
+	SouthWest
+	South
+	SouthEast
+)
+
+var direction8Names = [8]string{"East", "NorthEast", "North", "NorthWest", "West", "SouthWest", "South", "SouthEast"}
+
+// String returns the name of d, such as "NorthEast".
+func (d Direction8) String() string { return direction8Names[d.wrap()] }
+
+// wrap returns d reduced to [0, 8).
+func (d Direction8) wrap() Direction8 { return (d%8 + 8) % 8 }
+
+// Rotate returns the direction steps times 45° counter-clockwise from d.
+// Negative steps turn clockwise.
+func (d Direction8) Rotate(steps int) Direction8 { return (d + Direction8(steps)).wrap() }
+
+// Opposite returns the direction facing away from d.
+func (d Direction8) Opposite() Direction8 { return d.Rotate(4) }
+
+// Angle returns the angle of d in radians, counter-clockwise from +X.
+func (d Direction8) Angle() float64 { return float64(d.wrap()) * math.Pi / 4 }
+
+// ToVec2 returns the unit vector pointing in direction d.
+func (d Direction8) ToVec2() Vec2 { return Normalize2(As2[float64](d.ToVec2i())) }
+
+// ToVec2i returns the grid step in direction d, with components in {-1, 0, 1}.
+func (d Direction8) ToVec2i() Vec2i { return gridDirs8[d.wrap()] }
+
+// Direction8FromVec2 returns the direction nearest to that of v.
+// It returns East for the zero vector.
+func Direction8FromVec2(v Vec2) Direction8 {
+	return Direction8(int(math.Round(Angle2(v) / (math.Pi / 4)))).wrap()
+}
+
+// SnapToCardinal returns the one of the four unit vectors ±X, ±Y nearest in
+// direction to v, or the zero vector if v is zero.
+func SnapToCardinal(v Vec2) Vec2 {
+	if v == (Vec2{}) {
+		return v
+	}
+	i := int(math.Round(Angle2(v) / (math.Pi / 2)))
+	return As2[float64](gridDirs4[(i%4+4)%4])
+}
+
+// SnapToOctant returns the one of the eight unit vectors at multiples of 45°
+// nearest in direction to v, or the zero vector if v is zero.
+func SnapToOctant(v Vec2) Vec2 {
+	if v == (Vec2{}) {
+		return v
+	}
+	return Direction8FromVec2(v).ToVec2()
+}
@@ -0,0 +1,101 @@
+package vec
+
+import "math"
+
+// DualQuat is a dual quaternion Real + εDual. Unit dual quaternions
+// represent rigid motions; transforming by a*b applies b first and then a.
+type DualQuat struct{ Real, Dual Quat }
+
+// IdentityDualQuat returns the dual quaternion of no motion.
+func IdentityDualQuat() DualQuat { return DualQuat{Real: IdentityQuat()} }
+
+// DualQuatFromRotationTranslation returns the rigid motion that rotates by
+// the unit quaternion r and then translates by t.
+func DualQuatFromRotationTranslation(r Quat, t Vec3) DualQuat {
+	return DualQuat{r, Quat{t.X, t.Y, t.Z, 0}.Mul(r).Scale(0.5)}
+}
+
+// Mul returns the product a*b.
+func (a DualQuat) Mul(b DualQuat) DualQuat {
+	return DualQuat{a.Real.Mul(b.Real), a.Real.Mul(b.Dual).Add(a.Dual.Mul(b.Real))}
+}
+
+// Conj returns the quaternion conjugate of a, which is its inverse if a is
+// a unit dual quaternion.
+func (a DualQuat) Conj() DualQuat { return DualQuat{a.Real.Conj(), a.Dual.Conj()} }
+
+// Normalize returns a scaled to unit length, with the dual part made
+// orthogonal to the real part so that it represents a rigid motion.
+// It returns the identity if the real part of a is zero.
+func (a DualQuat) Normalize() DualQuat {
+	l := a.Real.Len()
+	if l == 0 {
+		return IdentityDualQuat()
+	}
+	r, d := a.Real.Scale(1/l), a.Dual.Scale(1/l)
+	return DualQuat{r, d.Add(r.Scale(-r.Dot(d)))}
+}
+
+// Rotation returns the rotation part of the unit dual quaternion a.
+func (a DualQuat) Rotation() Quat { return a.Real }
+
+// Translation returns the translation part of the unit dual quaternion a.
+func (a DualQuat) Translation() Vec3 { return a.Dual.Mul(a.Real.Conj()).Vec().Muls(2) }
+
+// TransformPoint returns the point p moved by the unit dual quaternion a.
+func (a DualQuat) TransformPoint(p Vec3) Vec3 { return a.Real.Rotate(p).Add(a.Translation()) }
+
+// TransformVector returns the direction v rotated by the unit dual
+// quaternion a, ignoring translation.
+func (a DualQuat) TransformVector(v Vec3) Vec3 { return a.Real.Rotate(v) }
+
+// ScLerpDualQuat interpolates between the unit dual quaternions a and b by t
+// along the screw motion joining them, taking the shorter path. The
+// rotation and translation advance together at constant speed.
+func ScLerpDualQuat(a, b DualQuat, t float64) DualQuat {
+	if a.Real.Dot(b.Real) < 0 {
+		b = DualQuat{b.Real.Scale(-1), b.Dual.Scale(-1)}
+	}
+	return a.Mul(a.Conj().Mul(b).pow(t))
+}
+
+// pow returns the unit dual quaternion a raised to the power t, by scaling
+// the angle and the distance of its screw motion.
+func (a DualQuat) pow(t float64) DualQuat {
+	r, d := a.Real, a.Dual
+	sinHalf := Len3(r.Vec())
+	if sinHalf < 1e-9 {
+		// Pure translation
+		return DualQuat{IdentityQuat(), Quat{d.X * t, d.Y * t, d.Z * t, 0}}
+	}
+	angle := 2 * math.Atan2(sinHalf, r.W)
+	axis := r.Vec().Divs(sinHalf)
+	dist := -2 * d.W / sinHalf
+	moment := d.Vec().Sub(axis.Muls(dist / 2 * r.W)).Divs(sinHalf)
+
+	angle, dist = angle*t, dist*t
+	sin, cos := math.Sincos(angle / 2)
+	rv := axis.Muls(sin)
+	dv := moment.Muls(sin).Add(axis.Muls(dist / 2 * cos))
+	return DualQuat{Quat{rv.X, rv.Y, rv.Z, cos}, Quat{dv.X, dv.Y, dv.Z, -dist / 2 * sin}}
+}
+
+// BlendDualQuats returns the weighted blend of the unit dual quaternions qs,
+// as used for dual quaternion skinning. Each motion is flipped onto the
+// hemisphere of the first before summing, and the sum is normalized.
+// It panics if qs and weights differ in length.
+func BlendDualQuats(qs []DualQuat, weights []float64) DualQuat {
+	if len(qs) != len(weights) {
+		panic("vec: BlendDualQuats length mismatch")
+	}
+	var sum DualQuat
+	for i, q := range qs {
+		w := weights[i]
+		if q.Real.Dot(qs[0].Real) < 0 {
+			w = -w
+		}
+		sum.Real = sum.Real.Add(q.Real.Scale(w))
+		sum.Dual = sum.Dual.Add(q.Dual.Scale(w))
+	}
+	return sum.Normalize()
+}
@@ -0,0 +1,164 @@
+package vec
+
+import "math"
+
+// DubinsPath is the shortest path between two oriented points for a vehicle
+// that only drives forward and turns with a bounded radius.
+// It consists of three segments, each a left turn (L), right turn (R) or
+// straight line (S), as named by Word.
+type DubinsPath struct {
+	Start   Vec2
+	Heading float64 // initial heading in radians
+	Radius  float64 // turning radius
+	Word    string  // segment kinds, e.g. "LSR"
+	Lengths [3]float64
+}
+
+// Dubins returns the shortest Dubins path from start with heading
+// startHeading to end with heading endHeading, using the given turning radius.
+// Headings are in radians, counter-clockwise from the +X axis.
+// Returns false if radius is not positive.
+func Dubins(start Vec2, startHeading float64, end Vec2, endHeading, radius float64) (DubinsPath, bool) {
+	if radius <= 0 {
+		return DubinsPath{}, false
+	}
+	d := end.Sub(start)
+	dist := Len2(d) / radius
+	theta := mod2Pi(Angle2(d))
+	a := mod2Pi(startHeading - theta)
+	b := mod2Pi(endHeading - theta)
+
+	best := DubinsPath{Start: start, Heading: startHeading, Radius: radius}
+	bestLen := math.Inf(1)
+	for _, word := range [...]string{"LSL", "RSR", "LSR", "RSL", "RLR", "LRL"} {
+		t, p, q, ok := dubinsWord(word, a, b, dist)
+		if ok && t+p+q < bestLen {
+			bestLen = t + p + q
+			best.Word = word
+			best.Lengths = [3]float64{t * radius, p * radius, q * radius}
+		}
+	}
+	return best, bestLen < math.Inf(1)
+}
+
+// dubinsWord returns the normalized segment lengths of one path family for a
+// unit turning radius, where the goal lies at distance d along the +X axis
+// and a, b are the start and end headings relative to it.
+func dubinsWord(word string, a, b, d float64) (t, p, q float64, ok bool) {
+	sa, ca := math.Sincos(a)
+	sb, cb := math.Sincos(b)
+	cab := math.Cos(a - b)
+	switch word {
+	case "LSL":
+		psq := 2 + d*d - 2*cab + 2*d*(sa-sb)
+		if psq < 0 {
+			return 0, 0, 0, false
+		}
+		phi := math.Atan2(cb-ca, d+sa-sb)
+		return mod2Pi(phi - a), math.Sqrt(psq), mod2Pi(b - phi), true
+	case "RSR":
+		psq := 2 + d*d - 2*cab + 2*d*(sb-sa)
+		if psq < 0 {
+			return 0, 0, 0, false
+		}
+		phi := math.Atan2(ca-cb, d-sa+sb)
+		return mod2Pi(a - phi), math.Sqrt(psq), mod2Pi(phi - b), true
+	case "LSR":
+		psq := -2 + d*d + 2*cab + 2*d*(sa+sb)
+		if psq < 0 {
+			return 0, 0, 0, false
+		}
+		p = math.Sqrt(psq)
+		phi := math.Atan2(-ca-cb, d+sa+sb) - math.Atan2(-2, p)
+		return mod2Pi(phi - a), p, mod2Pi(phi - b), true
+	case "RSL":
+		psq := -2 + d*d + 2*cab - 2*d*(sa+sb)
+		if psq < 0 {
+			return 0, 0, 0, false
+		}
+		p = math.Sqrt(psq)
+		phi := math.Atan2(ca+cb, d-sa-sb) - math.Atan2(2, p)
+		return mod2Pi(a - phi), p, mod2Pi(b - phi), true
+	case "RLR":
+		c := (6 - d*d + 2*cab + 2*d*(sa-sb)) / 8
+		if math.Abs(c) > 1 {
+			return 0, 0, 0, false
+		}
+		phi := math.Atan2(ca-cb, d-sa+sb)
+		p = mod2Pi(2*math.Pi - math.Acos(c))
+		t = mod2Pi(a - phi + p/2)
+		return t, p, mod2Pi(a - b - t + p), true
+	case "LRL":
+		c := (6 - d*d + 2*cab + 2*d*(sb-sa)) / 8
+		if math.Abs(c) > 1 {
+			return 0, 0, 0, false
+		}
+		phi := math.Atan2(ca-cb, d+sa-sb)
+		p = mod2Pi(2*math.Pi - math.Acos(c))
+		t = mod2Pi(-a - phi + p/2)
+		return t, p, mod2Pi(b - a - t + p), true
+	}
+	return 0, 0, 0, false
+}
+
+// Length returns the total length of p.
+func (p DubinsPath) Length() float64 { return p.Lengths[0] + p.Lengths[1] + p.Lengths[2] }
+
+// At returns the position and heading at arc length s along p.
+// s is clamped to [0, p.Length()]. A path without segments, as Dubins
+// returns on failure, stays at Start.
+func (p DubinsPath) At(s float64) (pos Vec2, heading float64) {
+	pos, heading = p.Start, p.Heading
+	if len(p.Word) == 0 {
+		return pos, heading
+	}
+	s = max(s, 0)
+	for i, l := range p.Lengths {
+		step := min(s, l)
+		pos, heading = dubinsAdvance(pos, heading, p.Word[i], step, p.Radius)
+		s -= step
+	}
+	return pos, heading
+}
+
+// Sample returns points along p spaced at most step apart,
+// including both endpoints.
+func (p DubinsPath) Sample(step float64) []Vec2 {
+	total := p.Length()
+	n := 1
+	if step > 0 {
+		n = max(int(math.Ceil(total/step)), 1)
+	}
+	ps := make([]Vec2, n+1)
+	for i := range ps {
+		ps[i], _ = p.At(total * float64(i) / float64(n))
+	}
+	return ps
+}
+
+// dubinsAdvance moves a pose by arc length l along a segment of the given kind.
+func dubinsAdvance(pos Vec2, heading float64, kind byte, l, radius float64) (Vec2, float64) {
+	switch kind {
+	case 'L':
+		t := l / radius
+		sin0, cos0 := math.Sincos(heading)
+		sin1, cos1 := math.Sincos(heading + t)
+		return pos.Add(Vec2{sin1 - sin0, cos0 - cos1}.Scale(radius)), heading + t
+	case 'R':
+		t := l / radius
+		sin0, cos0 := math.Sincos(heading)
+		sin1, cos1 := math.Sincos(heading - t)
+		return pos.Add(Vec2{sin0 - sin1, cos1 - cos0}.Scale(radius)), heading - t
+	}
+	sin, cos := math.Sincos(heading)
+	return pos.Add(Vec2{cos, sin}.Scale(l)), heading
+}
+
+// mod2Pi wraps a to [0, 2π).
+func mod2Pi(a float64) float64 {
+	a = math.Mod(a, 2*math.Pi)
+	if a < 0 {
+		a += 2 * math.Pi
+	}
+	return a
+}
@@ -0,0 +1,40 @@
+package vec
+
+// GeoM is the method set of *ebiten.GeoM used by the adapters below. It is
+// declared here so this package does not depend on Ebiten; pass a
+// *ebiten.GeoM wherever a GeoM is expected.
+type GeoM interface {
+	Apply(x, y float64) (float64, float64)
+	Element(i, j int) float64
+	SetElement(i, j int, e float64)
+	Translate(tx, ty float64)
+}
+
+// ApplyGeoM returns v transformed by g.
+func ApplyGeoM(v Vec2, g GeoM) Vec2 {
+	x, y := g.Apply(v.X, v.Y)
+	return Vec2{x, y}
+}
+
+// SetGeoM sets g to the transform a.
+func SetGeoM(g GeoM, a Affine2) {
+	for i := range 2 {
+		for j := range 3 {
+			g.SetElement(i, j, a[i][j])
+		}
+	}
+}
+
+// AffineFromGeoM returns the transform held by g.
+func AffineFromGeoM(g GeoM) Affine2 {
+	var a Affine2
+	for i := range 2 {
+		for j := range 3 {
+			a[i][j] = g.Element(i, j)
+		}
+	}
+	return a
+}
+
+// TranslateGeoM appends a translation by v to g.
+func TranslateGeoM(g GeoM, v Vec2) { g.Translate(v.X, v.Y) }
@@ -0,0 +1,113 @@
+package vec
+
+import (
+	"math"
+	"sort"
+)
+
+// EigenSym returns the eigenvalues of the symmetric matrix m in decreasing
+// order, with the corresponding unit eigenvectors.
+// Only the upper triangle of m is read.
+func (m Mat2) EigenSym() (values Vec2, vectors [2]Vec2) {
+	a, b, c := m[0][0], m[0][1], m[1][1]
+	mean := (a + c) / 2
+	r := math.Hypot((a-c)/2, b)
+	theta := math.Atan2(2*b, a-c) / 2
+	sin, cos := math.Sincos(theta)
+	return Vec2{mean + r, mean - r}, [2]Vec2{{cos, sin}, {-sin, cos}}
+}
+
+// EigenSym returns the eigenvalues of the symmetric matrix m in decreasing
+// order, with the corresponding unit eigenvectors.
+// Only the upper triangle of m is read.
+func (m Mat3) EigenSym() (values Vec3, vectors [3]Vec3) {
+	a := make([][]float64, 3)
+	for i := range a {
+		a[i] = []float64{m[0][i], m[1][i], m[2][i]}
+		for j := i; j < 3; j++ {
+			a[i][j] = m[i][j]
+		}
+	}
+	vals, vecs := jacobiEigen(a)
+	for i := range 3 {
+		vectors[i] = Vec3{vecs[0][i], vecs[1][i], vecs[2][i]}
+	}
+	return Vec3{vals[0], vals[1], vals[2]}, vectors
+}
+
+// jacobiEigen diagonalizes the symmetric matrix a in place using cyclic
+// Jacobi rotations. It returns the eigenvalues in decreasing order and a
+// matrix whose columns are the corresponding unit eigenvectors.
+// Only the upper triangle of a is read.
+func jacobiEigen(a [][]float64) ([]float64, [][]float64) {
+	n := len(a)
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			a[j][i] = a[i][j]
+		}
+	}
+	v := make([][]float64, n)
+	for i := range v {
+		v[i] = make([]float64, n)
+		v[i][i] = 1
+	}
+
+	for range 64 {
+		var off, total float64
+		for i := range n {
+			for j := range n {
+				if i != j {
+					off += a[i][j] * a[i][j]
+				}
+				total += a[i][j] * a[i][j]
+			}
+		}
+		if off <= 1e-30*total {
+			break
+		}
+		for p := range n {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := range n {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p], a[k][q] = c*akp-s*akq, s*akp+c*akq
+				}
+				for k := range n {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k], a[q][k] = c*apk-s*aqk, s*apk+c*aqk
+				}
+				for k := range n {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p], v[k][q] = c*vkp-s*vkq, s*vkp+c*vkq
+				}
+			}
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return a[order[i]][order[i]] > a[order[j]][order[j]] })
+	vals := make([]float64, n)
+	vecs := make([][]float64, n)
+	for i := range vecs {
+		vecs[i] = make([]float64, n)
+	}
+	for c, k := range order {
+		vals[c] = a[k][k]
+		for r := range n {
+			vecs[r][c] = v[r][k]
+		}
+	}
+	return vals, vecs
+}
@@ -0,0 +1,108 @@
+package vec
+
+import "math"
+
+// EulerOrder is the order in which Euler angle rotations are applied.
+//
+// Euler angles are stored in a Vec3 holding the rotation in radians about
+// each of the X, Y and Z axes, counter-clockwise by the right-hand rule.
+// The rotations are extrinsic: each one turns about a fixed world axis, in
+// the order named, so EulerXYZ rotates about X first, then Y, then Z, and
+// its matrix is Rz*Ry*Rx. This equals the intrinsic rotation in the reverse
+// order about the turning body's own axes, here Z, then Y, then X.
+type EulerOrder int
+
+const (
+	EulerXYZ EulerOrder = iota
+	EulerXZY
+	EulerYXZ
+	EulerYZX
+	EulerZXY
+	EulerZYX
+)
+
+// axes returns the axis indices in the order their rotations are applied.
+func (o EulerOrder) axes() (i, j, k int) {
+	switch o {
+	case EulerXYZ:
+		return 0, 1, 2
+	case EulerXZY:
+		return 0, 2, 1
+	case EulerYXZ:
+		return 1, 0, 2
+	case EulerYZX:
+		return 1, 2, 0
+	case EulerZXY:
+		return 2, 0, 1
+	case EulerZYX:
+		return 2, 1, 0
+	}
+	panic("vec: invalid EulerOrder")
+}
+
+// String returns the axis sequence of o, such as "XYZ".
+func (o EulerOrder) String() string {
+	i, j, k := o.axes()
+	return string([]byte{"XYZ"[i], "XYZ"[j], "XYZ"[k]})
+}
+
+// QuatFromEuler returns the rotation given by the Euler angles applied in order.
+func QuatFromEuler(angles Vec3, order EulerOrder) Quat {
+	i, j, k := order.axes()
+	a := ToArray3(angles)
+	q := IdentityQuat()
+	for _, axis := range [3]int{i, j, k} {
+		var u [3]float64
+		u[axis] = 1
+		q = QuatFromAxisAngle(FromArray3(u), a[axis]).Mul(q)
+	}
+	return q
+}
+
+// EulerFromQuat returns the Euler angles of the unit quaternion q in order.
+// See EulerFromMat3 for the ranges of the angles.
+func EulerFromQuat(q Quat, order EulerOrder) Vec3 { return EulerFromMat3(q.Mat3(), order) }
+
+// Mat3FromEuler returns the rotation matrix of the Euler angles applied in order.
+func Mat3FromEuler(angles Vec3, order EulerOrder) Mat3 {
+	i, j, k := order.axes()
+	a := ToArray3(angles)
+	m := Identity3()
+	for _, axis := range [3]int{i, j, k} {
+		m = axisRotation3(axis, a[axis]).Mul(m)
+	}
+	return m
+}
+
+// EulerFromMat3 returns the Euler angles in order of the rotation matrix m.
+// The middle rotation of the order is in [-π/2, π/2] and the others are in
+// [-π, π]. At gimbal lock, when the middle angle is ±π/2, the last
+// rotation is taken to be zero.
+func EulerFromMat3(m Mat3, order EulerOrder) Vec3 {
+	i, j, k := order.axes()
+	// s is the parity of the axis permutation.
+	s := 1.0
+	if (j-i+3)%3 != 1 {
+		s = -1
+	}
+	var a [3]float64
+	a[j] = math.Asin(max(-1, min(1, -s*m[k][i])))
+	if math.Hypot(m[k][j], m[k][k]) > 1e-9 {
+		a[i] = math.Atan2(s*m[k][j], m[k][k])
+		a[k] = math.Atan2(s*m[j][i], m[i][i])
+	} else {
+		a[i] = math.Atan2(-s*m[j][k], m[j][j])
+	}
+	return FromArray3(a)
+}
+
+// axisRotation3 returns the matrix rotating by angle about the axis with
+// index axis.
+func axisRotation3(axis int, angle float64) Mat3 {
+	sin, cos := math.Sincos(angle)
+	m := Identity3()
+	u, v := (axis+1)%3, (axis+2)%3
+	m[u][u], m[u][v] = cos, -sin
+	m[v][u], m[v][v] = sin, cos
+	return m
+}
@@ -0,0 +1,44 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleAlignPointSets2() {
+	src := []vec.Vec2{{0, 0}, {1, 0}, {0, 1}}
+	// dst is src rotated by 90 degrees, doubled in size and moved by (5, 5).
+	dst := []vec.Vec2{{5, 5}, {5, 7}, {3, 5}}
+
+	rot, t, scale := vec.AlignPointSets2(src, dst, true)
+	fmt.Printf("Angle: %.1f degrees\n", math.Atan2(rot[1][0], rot[0][0])*180/math.Pi)
+	fmt.Printf("Translation: (%.1f, %.1f)\n", t.X, t.Y)
+	fmt.Printf("Scale: %.1f\n", scale)
+
+	// Output:
+	// Angle: 90.0 degrees
+	// Translation: (5.0, 5.0)
+	// Scale: 2.0
+}
+
+func ExampleAlignPointSets3() {
+	src := []vec.Vec3{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	// Rotate 90 degrees around Z and move by (1, 2, 3).
+	dst := make([]vec.Vec3, len(src))
+	for i, p := range src {
+		dst[i] = vec.Vec3{-p.Y + 1, p.X + 2, p.Z + 3}
+	}
+
+	rot, t, scale := vec.AlignPointSets3(src, dst, false)
+	x := rot.MulVec(vec.Vec3{1, 0, 0})
+	fmt.Println("X axis maps to Y axis:", vec.Len3(x.Sub(vec.Vec3{0, 1, 0})) < 1e-9)
+	fmt.Printf("Translation: (%.1f, %.1f, %.1f)\n", t.X, t.Y, t.Z)
+	fmt.Printf("Scale: %.1f\n", scale)
+
+	// Output:
+	// X axis maps to Y axis: true
+	// Translation: (1.0, 2.0, 3.0)
+	// Scale: 1.0
+}
@@ -0,0 +1,25 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleDeltaAngle() {
+	fmt.Printf("%.4f\n", vec.Radians(180))
+	fmt.Println(vec.Degrees(math.Pi / 2))
+	fmt.Printf("%.0f\n", vec.Degrees(vec.WrapAngle(vec.Radians(-270))))
+
+	a, b := vec.Radians(170), vec.Radians(-170)
+	fmt.Printf("%.0f\n", vec.Degrees(vec.DeltaAngle(a, b)))
+	fmt.Printf("%.0f\n", vec.Degrees(vec.LerpAngle(a, b, 0.5)))
+
+	// Output:
+	// 3.1416
+	// 90
+	// 90
+	// 20
+	// 180
+}
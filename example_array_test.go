@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+// mglVec3 stands in for mgl32.Vec3.
+type mglVec3 [3]float32
+
+func ExampleFromArrays3() {
+	m := vec.ToArrayAs3[mglVec3](vec.Vec3g[float32]{1, 2, 3})
+	fmt.Println(m, vec.FromArray3(m))
+
+	// Slices share memory, so writes through one are visible in the other.
+	verts := []mglVec3{{0, 0, 0}, {1, 1, 1}}
+	vs := vec.FromArrays3(verts)
+	vs[1] = vs[1].Muls(2)
+	fmt.Println(verts)
+
+	fmt.Println(vec.Vec2i{4, 5}.Array())
+
+	// Output:
+	// [1 2 3] (1, 2, 3)
+	// [[0 0 0] [2 2 2]]
+	// [4 5]
+}
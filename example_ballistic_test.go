@@ -0,0 +1,36 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleLaunchVelocity() {
+	gravity := vec.Vec2{0, -10}
+	cannon, target := vec.Vec2{0, 0}, vec.Vec2{10, 2}
+
+	low, high, ok := vec.LaunchVelocity(cannon, target, 15, gravity)
+	fmt.Printf("%v %.2f %.2f\n", ok, low, high)
+
+	t, _ := vec.TimeOfFlight(high, gravity, 2)
+	fmt.Printf("%.2f %.2f\n", t, vec.BallisticPosition(cannon, high, gravity, t))
+
+	// Output:
+	// true (13.57, 6.40) (3.61, 14.56)
+	// 2.77 (10.00, 2.00)
+}
+
+func ExampleLaunchVelocityApex() {
+	// Lob a grenade to peak 3 above the hand, with Y up.
+	gravity := vec.Vec3{0, -9.8, 0}
+	v, t, ok := vec.LaunchVelocityApex(vec.Vec3{0, 1, 0}, vec.Vec3{8, 0, 6}, 3, gravity)
+	fmt.Printf("%v %.2f %.2f\n", ok, v, t)
+
+	arc := vec.SampleTrajectory(vec.Vec3{0, 1, 0}, v, gravity, t, 5)
+	fmt.Printf("%.2f\n", arc)
+
+	// Output:
+	// true (4.75, 7.67, 3.56) 1.69
+	// [(0.00, 1.00, 0.00) (2.00, 3.36, 1.50) (4.00, 3.98, 3.00) (6.00, 2.86, 4.50) (8.00, 0.00, 6.00)]
+}
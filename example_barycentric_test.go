@@ -0,0 +1,37 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleBarycentric2() {
+	a, b, c := vec.Vec2{0, 0}, vec.Vec2{4, 0}, vec.Vec2{0, 4}
+	u, v, w := vec.Barycentric2(vec.Vec2{1, 2}, a, b, c)
+	fmt.Println(u, v, w)
+
+	// Interpolate per-vertex colors at that point.
+	red := vec.Vec4{1, 0, 0, 1}
+	green := vec.Vec4{0, 1, 0, 1}
+	blue := vec.Vec4{0, 0, 1, 1}
+	fmt.Println(vec.InterpolateBarycentric4(red, green, blue, u, v, w))
+
+	// Output:
+	// 0.25 0.25 0.5
+	// (0.25, 0.25, 0.5, 1)
+}
+
+func ExampleBarycentric3() {
+	a, b, c := vec.Vec3{0, 0, 0}, vec.Vec3{2, 0, 0}, vec.Vec3{0, 2, 0}
+	// Points off the plane are projected onto it.
+	u, v, w := vec.Barycentric3(vec.Vec3{1, 1, 5}, a, b, c)
+	fmt.Println(u, v, w)
+
+	uvA, uvB, uvC := vec.Vec2{0, 0}, vec.Vec2{1, 0}, vec.Vec2{0, 1}
+	fmt.Println(vec.InterpolateBarycentric2(uvA, uvB, uvC, u, v, w))
+
+	// Output:
+	// 0 0.5 0.5
+	// (0.5, 0.5)
+}
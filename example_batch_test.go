@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleAddSlices4() {
+	type V4 = vec.Vec4g[float32]
+	pos := []V4{{0, 0, 0, 1}, {1, 2, 3, 1}}
+	vel := []V4{{1, 0, 0, 0}, {0, 1, 0, 0}}
+
+	// Step the particles, then move them all by (10, 0, 0).
+	vec.AddSlices4(pos, pos, vel)
+	m := vec.Identity4()
+	m[0][3] = 10
+	vec.TransformSlice4(pos, pos, m)
+	fmt.Println(pos)
+
+	dots := make([]float32, len(pos))
+	vec.DotSlices4(dots, pos, vel)
+	fmt.Println(dots)
+
+	// Output:
+	// [(11, 0, 0, 1) (11, 3, 3, 1)]
+	// [11 3]
+}
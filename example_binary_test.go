@@ -0,0 +1,37 @@
+package vec_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func Example_binary() {
+	b, _ := vec.Vec2g[float32]{1, -2}.MarshalBinary()
+	fmt.Printf("% x\n", b)
+
+	// AppendBinary writes into an existing buffer without allocating.
+	buf := make([]byte, 0, 64)
+	buf, _ = vec.Vec3g[int16]{1, 2, -1}.AppendBinary(buf)
+	buf, _ = vec.Vec2g[uint8]{7, 255}.AppendBinary(buf)
+	fmt.Printf("% x\n", buf)
+
+	var v vec.Vec3g[int16]
+	err := v.UnmarshalBinary(buf[:6])
+	fmt.Println(v, err)
+
+	// gob picks up the binary encoding automatically.
+	var network bytes.Buffer
+	gob.NewEncoder(&network).Encode(vec.Vec3{1, 2, 3})
+	var w vec.Vec3
+	gob.NewDecoder(&network).Decode(&w)
+	fmt.Println(w)
+
+	// Output:
+	// 00 00 80 3f 00 00 00 c0
+	// 01 00 02 00 ff ff 07 ff
+	// (1, 2, -1) <nil>
+	// (1, 2, 3)
+}
@@ -0,0 +1,44 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleConvexHull2() {
+	points := []vec.Vec2{{0, 0}, {2, 0}, {1, 1}, {2, 2}, {0, 2}, {1, 0}}
+	fmt.Println(vec.ConvexHull2(points))
+
+	// Output:
+	// [(0, 0) (2, 0) (2, 2) (0, 2)]
+}
+
+func ExampleMinBoundingCircle() {
+	points := []vec.Vec2{{0, 0}, {4, 0}, {2, 1}, {2, -1}, {1, 0.5}}
+	c := vec.MinBoundingCircle(points)
+	fmt.Printf("Center: (%.2f, %.2f), radius: %.2f\n", c.Center.X, c.Center.Y, c.Radius)
+
+	// Output:
+	// Center: (2.00, 0.00), radius: 2.00
+}
+
+func ExampleMinAreaOBB() {
+	// A 4x2 rectangle rotated by 30 degrees, plus an interior point.
+	o := vec.OBB2{Center: vec.Vec2{1, 1}, HalfSize: vec.Vec2{2, 1}, Angle: math.Pi / 6}
+	corners := o.Corners()
+	points := append(corners[:], vec.Vec2{1, 1})
+
+	fit := vec.MinAreaOBB(points)
+	fmt.Printf("Center: (%.2f, %.2f)\n", fit.Center.X, fit.Center.Y)
+	fmt.Printf("Half size: (%.2f, %.2f)\n", fit.HalfSize.X, fit.HalfSize.Y)
+	fmt.Printf("Angle: %.1f degrees\n", fit.Angle*180/math.Pi)
+	fmt.Printf("Area: %.2f\n", fit.Area())
+
+	// Output:
+	// Center: (1.00, 1.00)
+	// Half size: (2.00, 1.00)
+	// Angle: 30.0 degrees
+	// Area: 8.00
+}
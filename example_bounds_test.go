@@ -0,0 +1,22 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleBounds2g() {
+	var b vec.Bounds2
+	fmt.Println(b.IsEmpty())
+
+	for _, p := range []vec.Vec2{{1, 2}, {-3, 4}, {2, -1}} {
+		b.Add(p)
+	}
+	b.AddRect(vec.Rect{Min: vec.Vec2{0, 0}, Max: vec.Vec2{5, 1}})
+	fmt.Println(b.IsEmpty(), b.Result())
+
+	// Output:
+	// true
+	// false {(-3, -1) (5, 4)}
+}
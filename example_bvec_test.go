@@ -0,0 +1,26 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleSelect3() {
+	v := vec.Vec3{-1, 2, -3}
+	neg := vec.LessThan3(v, vec.Vec3{})
+	fmt.Println(neg, neg.Any(), neg.All())
+
+	// Replace the negative components with zero.
+	fmt.Println(vec.Select3(neg, vec.Vec3{}, v))
+
+	// A point is inside a box when it is past Min and before Max on every axis.
+	p := vec.Vec3i{1, 5, 2}
+	inside := vec.GreaterThanEqual3(p, vec.Vec3i{0, 0, 0}).And(vec.LessThan3(p, vec.Vec3i{4, 4, 4}))
+	fmt.Println(inside, inside.All())
+
+	// Output:
+	// (true, false, true) true false
+	// (0, 2, 0)
+	// (true, false, true) false
+}
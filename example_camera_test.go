@@ -0,0 +1,29 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleCamera2D() {
+	cam := vec.Camera2D{
+		Position: vec.Vec2{100, 50},
+		Zoom:     2,
+		Viewport: vec.Rect{Max: vec.Vec2{640, 480}},
+	}
+	fmt.Println(cam.WorldToScreen(vec.Vec2{110, 50}))
+	fmt.Println(cam.ScreenToWorld(vec.Vec2{0, 0}))
+	fmt.Println(cam.VisibleBounds())
+
+	cam.Rotation = math.Pi / 2
+	s := cam.WorldToScreen(vec.Vec2{110, 50})
+	fmt.Printf("%.0f\n", s)
+
+	// Output:
+	// (340, 240)
+	// (-60, -70)
+	// {(-60, -70) (260, 170)}
+	// (320, 220)
+}
@@ -0,0 +1,37 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleShapeCast() {
+	player := vec.Circle{Center: vec.Vec2{0, 0}, Radius: 1}
+	world := []vec.Shape2{
+		vec.Rect{Min: vec.Vec2{5, -2}, Max: vec.Vec2{6, 2}},
+		vec.Circle{Center: vec.Vec2{0, 10}, Radius: 2},
+		vec.Polygon{{-3, -1}, {-3, 1}, {-9, 0}},
+	}
+
+	show := func(d vec.Vec2) {
+		hit, toi, n := vec.ShapeCast(player, d, world)
+		fmt.Printf("move %v: hit=%v toi=%.2f normal=%.2f\n", d, hit, toi, n)
+	}
+	show(vec.Vec2{10, 0})
+	show(vec.Vec2{0, 10})
+	show(vec.Vec2{-10, 0})
+	show(vec.Vec2{0, -10})
+
+	// A box sweeps the same way.
+	box := vec.Rect{Min: vec.Vec2{-1, -1}, Max: vec.Vec2{1, 1}}
+	hit, toi, n := vec.ShapeCast(box, vec.Vec2{10, 0}, world)
+	fmt.Printf("box: hit=%v toi=%.2f normal=%.2f\n", hit, toi, n)
+
+	// Output:
+	// move (10, 0): hit=true toi=0.40 normal=(-1.00, 0.00)
+	// move (0, 10): hit=true toi=0.70 normal=(0.00, -1.00)
+	// move (-10, 0): hit=true toi=0.20 normal=(1.00, 0.00)
+	// move (0, -10): hit=false toi=0.00 normal=(0.00, 0.00)
+	// box: hit=true toi=0.40 normal=(-1.00, 0.00)
+}
@@ -0,0 +1,62 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleCollide() {
+	ground := vec.Rect{Min: vec.Vec2{0, 0}, Max: vec.Vec2{10, 2}}
+	shapes := []vec.Shape2{
+		vec.Circle{Center: vec.Vec2{2, 2.5}, Radius: 1},
+		vec.Capsule2{A: vec.Vec2{5, 3}, B: vec.Vec2{7, 3}, Radius: 1.5},
+		vec.Polygon{{8, 1.8}, {9, 3}, {7, 3}},
+		vec.Rect{Min: vec.Vec2{0, 3}, Max: vec.Vec2{1, 4}},
+	}
+	for _, s := range shapes {
+		c, ok := vec.Collide(ground, s)
+		fmt.Printf("%v %v %v %.1f\n", ok, c.Point, c.Normal, c.Depth)
+	}
+
+	// Output:
+	// true (2, 1.75) (0, 1) 0.5
+	// true (6, 1.75) (0, 1) 0.5
+	// true (8, 1.9) (0, 1) 0.2
+	// false (0, 0) (0, 0) 0.0
+}
+
+func ExampleShape2() {
+	shapes := []vec.Shape2{
+		vec.Circle{Center: vec.Vec2{0, 0}, Radius: 1},
+		vec.Rect{Min: vec.Vec2{2, -1}, Max: vec.Vec2{4, 1}},
+		vec.Polygon{{5, -1}, {7, 0}, {5, 1}},
+	}
+	p := vec.Vec2{3, 3}
+	for _, s := range shapes {
+		lo, hi := s.Project(vec.Vec2{1, 0})
+		fmt.Printf("%v %v %.2f %v %v\n", s.Bounds(), s.ContainsPoint(p), s.ClosestPoint(p), lo, hi)
+	}
+
+	// Output:
+	// {(-1, -1) (1, 1)} false (0.71, 0.71) -1 1
+	// {(2, -1) (4, 1)} false (3.00, 1.00) 2 4
+	// {(5, -1) (7, 1)} false (5.00, 1.00) 5 7
+}
+
+func ExamplePolygonManifold() {
+	ground := vec.Polygon{{0, 0}, {10, 0}, {10, 2}, {0, 2}}
+	crate := vec.Polygon{{3, 1.5}, {5, 1.5}, {5, 3.5}, {3, 3.5}}
+
+	// The crate rests flat on the ground, so it touches at both corners.
+	m, ok := vec.PolygonManifold(ground, crate)
+	fmt.Println(ok, m.Normal, m.Points[:m.N], m.Depths[:m.N])
+
+	ball := vec.Circle{Center: vec.Vec2{7, 2.5}, Radius: 1}
+	m, ok = vec.CirclePolygonManifold(ball, ground)
+	fmt.Println(ok, m.Normal, m.Points[:m.N], m.MaxDepth())
+
+	// Output:
+	// true (0, 1) [(3, 1.75) (5, 1.75)] [0.5 0.5]
+	// true (0, -1) [(7, 1.75)] 0.5
+}
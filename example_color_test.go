@@ -0,0 +1,47 @@
+package vec_test
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleRGBToHSV() {
+	orange := vec.Vec3{1, 0.5, 0}
+	hsv := vec.RGBToHSV(orange)
+	fmt.Printf("%.3f\n", hsv)
+	fmt.Printf("%.3f\n", vec.HSVToRGB(hsv))
+	fmt.Printf("%.3f\n", vec.RGBToHSL(orange))
+
+	// Output:
+	// (30.000, 1.000, 1.000)
+	// (1.000, 0.500, 0.000)
+	// (30.000, 1.000, 0.500)
+}
+
+func ExampleRGBToOKLab() {
+	white := vec.RGBToOKLab(vec.Vec3{1, 1, 1})
+	fmt.Printf("%.3f\n", white)
+	red := vec.RGBToOKLCH(vec.Vec3{1, 0, 0})
+	fmt.Printf("%.3f\n", red)
+	back := vec.OKLCHToRGB(red).Vec4(1)
+	fmt.Println(color.NRGBAModel.Convert(vec.ToColor(back)))
+
+	// Output:
+	// (1.000, 0.000, 0.000)
+	// (0.628, 0.258, 29.234)
+	// {255 0 0 255}
+}
+
+func ExampleMixOKLab() {
+	red := vec.FromColor(color.RGBA{255, 0, 0, 255})
+	blue := vec.FromColor(color.RGBA{0, 0, 255, 255})
+	mid := vec.MixOKLab(red, blue, 0.5)
+	fmt.Printf("%.3f\n", mid)
+	fmt.Println(color.NRGBAModel.Convert(vec.ToColor(mid)))
+
+	// Output:
+	// (0.550, 0.326, 0.637, 1.000)
+	// {140 83 162 255}
+}
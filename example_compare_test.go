@@ -0,0 +1,30 @@
+package vec_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleCompare2() {
+	points := []vec.Vec2i{{2, 1}, {1, 5}, {2, 0}, {1, 2}}
+	slices.SortFunc(points, vec.Compare2)
+	fmt.Println(points)
+
+	i, found := slices.BinarySearchFunc(points, vec.Vec2i{2, 0}, vec.Compare2)
+	fmt.Println(i, found)
+
+	// Output:
+	// [(1, 2) (1, 5) (2, 0) (2, 1)]
+	// 2 true
+}
+
+func ExampleSortByAngle() {
+	points := []vec.Vec2i{{0, -1}, {-1, 0}, {2, 0}, {0, 1}, {1, 0}, {1, 1}}
+	vec.SortByAngle(points, vec.Vec2i{0, 0})
+	fmt.Println(points)
+
+	// Output:
+	// [(1, 0) (2, 0) (1, 1) (0, 1) (-1, 0) (0, -1)]
+}
@@ -0,0 +1,23 @@
+package vec_test
+
+import (
+	"fmt"
+	"math/cmplx"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleMulComplex() {
+	// A 90 degree rotation composed twice turns (1, 2) around.
+	quarter := vec.FromComplex(1i)
+	half := vec.MulComplex(quarter, quarter)
+	fmt.Println(vec.MulComplex(vec.Vec2{1, 2}, half))
+
+	fmt.Println(vec.ToComplex(vec.Vec2i{3, 4}))
+	fmt.Println(cmplx.Abs(vec.ToComplex(vec.Vec2{3, 4})))
+
+	// Output:
+	// (-1, -2)
+	// (3+4i)
+	// 5
+}
@@ -0,0 +1,74 @@
+package vec_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleVec3g_At() {
+	// Find the axis along which a box is longest, without a switch on X/Y/Z.
+	size := vec.Vec3{2, 7, 3}
+	longest := 0
+	for i := 1; i < 3; i++ {
+		if size.At(i) > size.At(longest) {
+			longest = i
+		}
+	}
+	fmt.Println(longest)
+
+	fmt.Println(size.WithAt(longest, 0))
+
+	size.SetAt(0, 5)
+	fmt.Println(size)
+
+	// Output:
+	// 1
+	// (2, 0, 3)
+	// (5, 7, 3)
+}
+
+func ExampleVec3g_WithY() {
+	// Flatten a velocity onto the ground plane.
+	vel := vec.Vec3{3, -9.8, 4}
+	fmt.Println(vel.WithY(0))
+
+	// Move a point onto the plane z = 1.
+	p := vec.Vec3{5, 6, 7}
+	fmt.Println(p.WithZ(1), p.WithXY(0, 0))
+
+	// Output:
+	// (3, 0, 4)
+	// (5, 6, 1) (0, 0, 7)
+}
+
+func ExampleVec3g_Components() {
+	v := vec.Vec3{1, 5, 3}
+	fmt.Println(slices.Collect(v.Components()))
+	for i, c := range v.All() {
+		if c > 2 {
+			fmt.Println(i)
+			break
+		}
+	}
+
+	// Output:
+	// [1 5 3]
+	// 1
+}
+
+func ExampleRectPoints() {
+	r := vec.Recti{Min: vec.Vec2i{-1, 2}, Max: vec.Vec2i{1, 4}}
+	fmt.Println(slices.Collect(vec.RectPoints(r)))
+
+	n := 0
+	for range vec.BoxPoints(vec.Boxi{Max: vec.Vec3i{2, 3, 4}}) {
+		n++
+	}
+	fmt.Println(n)
+
+	// Output:
+	// [(-1, 2) (0, 2) (-1, 3) (0, 3)]
+	// 24
+}
@@ -0,0 +1,25 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleRayMarchGrid() {
+	solid := map[vec.Vec2i]bool{{3, 1}: true}
+
+	for cell, t := range vec.RayMarchGrid(vec.Vec2{0.5, 0.5}, vec.Vec2{1, 0.4}, 1) {
+		fmt.Printf("%v t=%.2f\n", cell, t)
+		if solid[cell] || t > 10 {
+			break
+		}
+	}
+
+	// Output:
+	// (0, 0) t=0.00
+	// (1, 0) t=0.50
+	// (1, 1) t=1.25
+	// (2, 1) t=1.50
+	// (3, 1) t=2.50
+}
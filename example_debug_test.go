@@ -0,0 +1,34 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+// printer is a DebugDraw that prints each call.
+type printer struct{}
+
+func (printer) Line(a, b vec.Vec2)           { fmt.Println("line", a, b) }
+func (printer) Circle(c vec.Vec2, r float64) { fmt.Println("circle", c, r) }
+func (printer) Rect(r vec.Rect)              { fmt.Println("rect", r.Min, r.Max) }
+func (printer) Arrow(from, to vec.Vec2)      { fmt.Println("arrow", from, to) }
+func (printer) Text(at vec.Vec2, s string)   { fmt.Println("text", at, s) }
+
+func ExampleDebugRecorder() {
+	var rec vec.DebugRecorder
+	vec.DrawShape(&rec, vec.Circle{Center: vec.Vec2{1, 2}, Radius: 3})
+	vec.DrawShape(&rec, vec.Polygon{{0, 0}, {1, 0}, {0, 1}})
+	vec.DrawShape(&rec, vec.Segment2{A: vec.Vec2{2, 0}, B: vec.Vec2{2, 5}})
+	rec.Text(vec.Vec2{0, 0}, "origin")
+
+	rec.Replay(printer{})
+
+	// Output:
+	// circle (1, 2) 3
+	// line (0, 0) (1, 0)
+	// line (1, 0) (0, 1)
+	// line (0, 1) (0, 0)
+	// line (2, 0) (2, 5)
+	// text (0, 0) origin
+}
@@ -0,0 +1,27 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleDirection8() {
+	d := vec.Direction8FromVec2(vec.Vec2{3, 2.5})
+	fmt.Println(d, d.ToVec2i(), d.Opposite(), d.Rotate(-3))
+	fmt.Printf("%.3f\n", d.ToVec2())
+
+	// Output:
+	// NorthEast (1, 1) SouthWest South
+	// (0.707, 0.707)
+}
+
+func ExampleSnapToCardinal() {
+	v := vec.Vec2{-0.3, 2}
+	fmt.Println(vec.SnapToCardinal(v))
+	fmt.Printf("%.3f\n", vec.SnapToOctant(vec.Vec2{-1, 0.8}))
+
+	// Output:
+	// (0, 1)
+	// (-0.707, 0.707)
+}
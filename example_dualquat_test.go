@@ -0,0 +1,30 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleScLerpDualQuat() {
+	// Half a turn about Z while rising by 2 is a screw motion about the Z axis.
+	a := vec.IdentityDualQuat()
+	b := vec.DualQuatFromRotationTranslation(vec.QuatFromAxisAngle(vec.Vec3{0, 0, 1}, math.Pi), vec.Vec3{0, 0, 2})
+
+	// Points on the axis slide along it, and other points follow a helix.
+	for _, t := range []float64{0, 0.5, 1} {
+		m := vec.ScLerpDualQuat(a, b, t)
+		fmt.Printf("%.1f: %.3f\n", t, m.TransformPoint(vec.Vec3{1, 0, 0}))
+	}
+
+	// Blending motions with weights as in skinning.
+	c := vec.BlendDualQuats([]vec.DualQuat{a, b}, []float64{0.5, 0.5})
+	fmt.Printf("%.3f\n", c.Translation())
+
+	// Output:
+	// 0.0: (1.000, 0.000, 0.000)
+	// 0.5: (0.000, 1.000, 1.000)
+	// 1.0: (-1.000, 0.000, 2.000)
+	// (0.000, 0.000, 1.000)
+}
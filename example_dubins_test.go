@@ -0,0 +1,24 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleDubins() {
+	// Turn around: start heading +X, end 4 units up heading -X.
+	path, _ := vec.Dubins(vec.Vec2{0, 0}, 0, vec.Vec2{0, 4}, math.Pi, 2)
+	fmt.Println("Word:", path.Word)
+	fmt.Printf("Length: %.4f\n", path.Length())
+
+	points := path.Sample(0.5)
+	end := points[len(points)-1]
+	fmt.Printf("Samples: %d, end: (%.2f, %.2f)\n", len(points), end.X, end.Y)
+
+	// Output:
+	// Word: LSL
+	// Length: 6.2832
+	// Samples: 14, end: (0.00, 4.00)
+}
@@ -0,0 +1,38 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+// geoM mimics ebiten.GeoM.
+type geoM struct{ e [2][3]float64 }
+
+func (g *geoM) Element(i, j int) float64       { return g.e[i][j] }
+func (g *geoM) SetElement(i, j int, e float64) { g.e[i][j] = e }
+func (g *geoM) Translate(tx, ty float64)       { g.e[0][2] += tx; g.e[1][2] += ty }
+func (g *geoM) Apply(x, y float64) (float64, float64) {
+	return g.e[0][0]*x + g.e[0][1]*y + g.e[0][2], g.e[1][0]*x + g.e[1][1]*y + g.e[1][2]
+}
+
+func ExampleSetGeoM() {
+	// Rotate a sprite a quarter turn and place it at (100, 50).
+	a := vec.Translation2(vec.Vec2{100, 50}).Mul(vec.Rotation2(math.Pi / 2))
+
+	var g geoM
+	vec.SetGeoM(&g, a)
+	fmt.Printf("%.0f\n", vec.ApplyGeoM(vec.Vec2{10, 0}, &g))
+
+	vec.TranslateGeoM(&g, vec.Vec2{1, 1})
+	fmt.Printf("%.0f\n", vec.AffineFromGeoM(&g).Offset())
+
+	inv, _ := a.Inverse()
+	fmt.Printf("%.0f\n", inv.Apply(vec.Vec2{100, 60}))
+
+	// Output:
+	// (100, 60)
+	// (101, 51)
+	// (10, 0)
+}
@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleQuatFromEuler() {
+	// A quarter turn about X followed by a quarter turn about Z is not the
+	// same rotation as the other way around.
+	angles := vec.Vec3{math.Pi / 2, 0, math.Pi / 2}
+	v := vec.Vec3{0, 1, 0}
+	for _, order := range []vec.EulerOrder{vec.EulerXYZ, vec.EulerZYX} {
+		q := vec.QuatFromEuler(angles, order)
+		fmt.Printf("%v: %.0f\n", order, vec.Map3(q.Rotate(v), math.Round).Add(vec.Vec3{}))
+	}
+
+	// Converting back recovers the angles in the same order.
+	q := vec.QuatFromEuler(vec.Vec3{0.1, 0.2, 0.3}, vec.EulerYXZ)
+	fmt.Printf("%.2f\n", vec.EulerFromQuat(q, vec.EulerYXZ))
+
+	// Output:
+	// XYZ: (0, 0, 1)
+	// ZYX: (-1, 0, 0)
+	// (0.10, 0.20, 0.30)
+}
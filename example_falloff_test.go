@@ -0,0 +1,31 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func Example_falloff() {
+	for _, d := range []float64{0, 2.5, 5, 7.5, 10} {
+		fmt.Printf("dist %4.1f: linear %.3f, smooth %.3f, gauss %.3f\n", d,
+			vec.FalloffLinear(d, 10), vec.FalloffSmooth(d, 10), vec.FalloffGauss(d, 10))
+	}
+
+	// Output:
+	// dist  0.0: linear 1.000, smooth 1.000, gauss 1.000
+	// dist  2.5: linear 0.750, smooth 0.844, gauss 0.755
+	// dist  5.0: linear 0.500, smooth 0.500, gauss 0.325
+	// dist  7.5: linear 0.250, smooth 0.156, gauss 0.080
+	// dist 10.0: linear 0.000, smooth 0.000, gauss 0.000
+}
+
+func ExampleApplyRadialForce() {
+	points := []vec.Vec2{{1, 0}, {0, 5}, {20, 0}}
+	vec.ApplyRadialForce(points, vec.Vec2{0, 0}, 10, 2)
+	fmt.Printf("(%.3f, %.3f) (%.3f, %.3f) (%.3f, %.3f)\n",
+		points[0].X, points[0].Y, points[1].X, points[1].Y, points[2].X, points[2].Y)
+
+	// Output:
+	// (2.944, 0.000) (0.000, 6.000) (20.000, 0.000)
+}
@@ -0,0 +1,31 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleGradient2() {
+	// A bowl-shaped field, f(x, y) = x² + 3y².
+	f := func(p vec.Vec2) float64 { return p.X*p.X + 3*p.Y*p.Y }
+	p := vec.Vec2{1, 2}
+	fmt.Printf("%.3f %.3f\n", vec.Gradient2(f, p, 1e-4), vec.Laplacian2(f, p, 1e-3))
+
+	// The gradient field of f spreads out everywhere and does not turn.
+	grad := func(p vec.Vec2) vec.Vec2 { return vec.Gradient2(f, p, 1e-4) }
+	fmt.Printf("%.3f %.3f\n", vec.Divergence2(grad, p, 1e-3), vec.Curl2(grad, p, 1e-3))
+
+	// Output:
+	// (2.000, 12.000) 8.000
+	// 8.000 0.000
+}
+
+func ExampleGradient3() {
+	// The signed distance to the unit sphere: its gradient is the surface normal.
+	sphere := func(p vec.Vec3) float64 { return vec.Len3(p) - 1 }
+	fmt.Printf("%.3f\n", vec.Gradient3(sphere, vec.Vec3{0, 0.6, 0.8}, 1e-5))
+
+	// Output:
+	// (0.000, 0.600, 0.800)
+}
@@ -0,0 +1,56 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleFitLine2() {
+	points := []vec.Vec2{{0, 1}, {1, 3.1}, {2, 4.9}, {3, 7}}
+	l, rms, _ := vec.FitLine2(points)
+	fmt.Printf("Slope: %.3f\n", l.Dir.Y/l.Dir.X)
+	fmt.Printf("RMS error: %.3f\n", rms)
+
+	// Output:
+	// Slope: 1.981
+	// RMS error: 0.030
+}
+
+func ExampleFitPlane3() {
+	points := []vec.Vec3{{0, 0, 2}, {1, 0, 2}, {0, 1, 2}, {1, 1, 2}}
+	pl, rms, _ := vec.FitPlane3(points)
+	fmt.Printf("Normal: (%.0f, %.0f, %.0f), D: %.0f, RMS: %.0f\n",
+		pl.Normal.X, pl.Normal.Y, pl.Normal.Z, pl.D, rms)
+
+	// Points along a line lie in many planes, so none is chosen.
+	var line []vec.Vec3
+	for i := range 10 {
+		t := float64(i) * 0.1
+		line = append(line, vec.Vec3{t, 0.3 * t, 0.7 * t})
+	}
+	_, _, ok := vec.FitPlane3(line)
+	fmt.Println(ok)
+
+	// Output:
+	// Normal: (0, 0, 1), D: 2, RMS: 0
+	// false
+}
+
+func ExampleFitCircle2() {
+	points := []vec.Vec2{{3, 2}, {1, 4}, {-1, 2}, {1, 0}, {2.41, 3.41}}
+	c, rms, _ := vec.FitCircle2(points)
+	fmt.Printf("Center: (%.2f, %.2f), radius: %.2f, RMS: %.3f\n", c.Center.X, c.Center.Y, c.Radius, rms)
+
+	var line []vec.Vec2
+	for i := range 10 {
+		t := float64(i) * 0.1
+		line = append(line, vec.Vec2{t, 0.3*t + 0.1})
+	}
+	_, _, ok := vec.FitCircle2(line)
+	fmt.Println(ok)
+
+	// Output:
+	// Center: (1.00, 2.00), radius: 2.00, RMS: 0.002
+	// false
+}
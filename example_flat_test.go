@@ -0,0 +1,27 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleAsScalars3() {
+	verts := []vec.Vec3{{1, 2, 3}, {4, 5, 6}}
+
+	// The flat view shares memory with verts.
+	flat := vec.AsScalars3(verts)
+	flat[0] = 10
+	fmt.Println(flat, verts[0])
+
+	back, err := vec.AsVecs3(flat)
+	fmt.Println(back, err)
+
+	_, err = vec.AsVecs3(flat[:5])
+	fmt.Println(err)
+
+	// Output:
+	// [10 2 3 4 5 6] (10, 2, 3)
+	// [(10, 2, 3) (4, 5, 6)] <nil>
+	// vec: flat slice length 5 is not a multiple of 3
+}
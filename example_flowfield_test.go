@@ -0,0 +1,47 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleGenerateFlowField() {
+	level := []string{
+		".....",
+		".###.",
+		"...#G",
+	}
+	size := vec.Vec2i{len(level[0]), len(level)}
+	passable := func(p vec.Vec2i) bool { return level[p.Y][p.X] != '#' }
+	goal := []vec.Vec2i{{4, 2}}
+
+	dist := vec.DijkstraMap(size, goal, passable)
+	fmt.Printf("%.2f\n", dist.At(vec.Vec2i{0, 2}))
+
+	arrows := map[vec.Vec2i]string{
+		{1, 0}: "→", {1, 1}: "↘", {0, 1}: "↓", {-1, 1}: "↙",
+		{-1, 0}: "←", {-1, -1}: "↖", {0, -1}: "↑", {1, -1}: "↗",
+	}
+	field := vec.GenerateFlowField(size, goal, passable)
+	for p, d := range field.All() {
+		switch {
+		case !passable(p):
+			fmt.Print("#")
+		case d == vec.Vec2{}:
+			fmt.Print("G")
+		default:
+			fmt.Print(arrows[vec.As2[int](vec.Map2(d, math.Round))])
+		}
+		if p.X == size.X-1 {
+			fmt.Println()
+		}
+	}
+
+	// Output:
+	// 8.00
+	// →→→→↓
+	// ↑###↓
+	// ↑←←#G
+}
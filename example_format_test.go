@@ -0,0 +1,25 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func Example_formatting() {
+	v := vec.Vec2{3, 4.5}
+	fmt.Println(v)
+	fmt.Printf("%.2f\n", v)
+	fmt.Printf("%6.1f\n", v)
+	fmt.Printf("%+v\n", v)
+	fmt.Printf("%#v\n", v)
+	fmt.Println(vec.Vec3i{1, -2, 3}.String())
+
+	// Output:
+	// (3, 4.5)
+	// (3.00, 4.50)
+	// (   3.0,    4.5)
+	// (x: 3, y: 4.5)
+	// vec.Vec2g[float64]{X:3, Y:4.5}
+	// (1, -2, 3)
+}
@@ -0,0 +1,45 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleFieldOfView() {
+	level := []string{
+		"#########",
+		"#.......#",
+		"#...#...#",
+		"#.......#",
+		"#########",
+	}
+	opaque := func(p vec.Vec2i) bool {
+		return p.Y < 0 || p.Y >= len(level) || p.X < 0 || p.X >= len(level[p.Y]) || level[p.Y][p.X] == '#'
+	}
+
+	visible := map[vec.Vec2i]bool{}
+	for _, p := range vec.FieldOfView(vec.Vec2i{2, 2}, 10, opaque) {
+		visible[p] = true
+	}
+	for y, row := range level {
+		for x := range row {
+			switch {
+			case x == 2 && y == 2:
+				fmt.Print("@")
+			case visible[vec.Vec2i{x, y}]:
+				fmt.Print(string(row[x]))
+			default:
+				fmt.Print("?")
+			}
+		}
+		fmt.Println()
+	}
+
+	// Output:
+	// #########
+	// #......??
+	// #.@.#????
+	// #......??
+	// #########
+}
@@ -0,0 +1,47 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleHaversineDistance() {
+	paris := vec.Vec2{48.8566, 2.3522}
+	london := vec.Vec2{51.5074, -0.1278}
+	fmt.Printf("%.0f km\n", vec.HaversineDistance(paris, london)/1000)
+	fmt.Printf("%.1f°\n", vec.InitialBearing(paris, london))
+	// Output:
+	// 344 km
+	// 330.0°
+}
+
+func ExampleDestinationPoint() {
+	// Setting out east near the antimeridian wraps the longitude.
+	p := vec.DestinationPoint(vec.Vec2{10, 179.5}, 90, 100e3)
+	fmt.Printf("%.3f\n", p)
+	// Output:
+	// (9.999, -179.587)
+}
+
+func ExampleGeoToENU2() {
+	origin := vec.Vec2{35.68, 139.76}
+	enu := vec.GeoToENU2(origin, vec.Vec2{35.70, 139.80})
+	fmt.Printf("%.0f m east, %.0f m north\n", enu.X, enu.Y)
+	fmt.Printf("%.2f\n", vec.ENUToGeo2(origin, enu))
+	// Output:
+	// 3612 m east, 2225 m north
+	// (35.70, 139.80)
+}
+
+func ExampleGeoToENU3() {
+	// A drone 120 m above a point 1 km north of the origin.
+	origin := vec.Vec3{51.5, 0.1, 0}
+	drone := vec.ENUToGeo3(origin, vec.Vec3{0, 1000, 120})
+	fmt.Printf("%.5f %.5f %.0f\n", drone.X, drone.Y, drone.Z)
+	enu := vec.GeoToENU3(origin, drone)
+	fmt.Printf("%.0f m north, %.0f m up\n", enu.Y, enu.Z)
+	// Output:
+	// 51.50899 0.10000 120
+	// 1000 m north, 120 m up
+}
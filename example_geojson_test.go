@@ -0,0 +1,31 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleMarshalGeoJSONLineString() {
+	path := []vec.Vec2{{139.76, 35.68}, {139.8, 35.7}}
+	b, _ := vec.MarshalGeoJSONLineString(path)
+	fmt.Println(string(b))
+
+	back, err := vec.UnmarshalGeoJSONLineString(b)
+	fmt.Println(back, err)
+	// Output:
+	// {"type":"LineString","coordinates":[[139.76,35.68],[139.8,35.7]]}
+	// [(139.76, 35.68) (139.8, 35.7)] <nil>
+}
+
+func ExampleUnmarshalGeoJSONPolygon() {
+	data := []byte(`{"type": "Polygon", "coordinates": [[[0, 0], [4, 0], [4, 4], [0, 0]]]}`)
+	rings, err := vec.UnmarshalGeoJSONPolygon(data)
+	fmt.Println(rings, err)
+
+	_, err = vec.UnmarshalGeoJSONPoint(data)
+	fmt.Println(err)
+	// Output:
+	// [[(0, 0) (4, 0) (4, 4)]] <nil>
+	// vec: GeoJSON geometry has type "Polygon", want "Point"
+}
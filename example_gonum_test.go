@@ -0,0 +1,30 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+// dense mimics the part of gonum's mat.VecDense used here.
+type dense []float64
+
+func (d dense) AtVec(i int) float64 { return d[i] }
+
+func ExampleColumns3() {
+	pts := []vec.Vec3{{1, 2, 3}, {4, 5, 6}}
+
+	// mat.NewDense(3, len(pts), data) holds one point per column.
+	data := vec.Columns3(pts)
+	fmt.Println(data)
+	fmt.Println(vec.FromColumns3(data))
+
+	fmt.Println(vec.Float64s3(vec.Vec3i{7, 8, 9}))
+	fmt.Println(vec.FromVector3(dense{7, 8, 9}))
+
+	// Output:
+	// [1 4 2 5 3 6]
+	// [(1, 2, 3) (4, 5, 6)]
+	// [7 8 9]
+	// (7, 8, 9)
+}
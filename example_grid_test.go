@@ -0,0 +1,35 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleGrid() {
+	g := vec.NewGrid[rune](vec.Vec2i{4, 3})
+	g.Fill('.')
+	g.Set(vec.Vec2i{1, 1}, '#')
+
+	for p, c := range g.All() {
+		fmt.Print(string(c))
+		if p.X == g.Size.X-1 {
+			fmt.Println()
+		}
+	}
+
+	walls := 0
+	for _, c := range g.Neighbors(vec.Vec2i{0, 0}, true) {
+		if c == '#' {
+			walls++
+		}
+	}
+	_, ok := g.Get(vec.Vec2i{4, 0})
+	fmt.Println(walls, ok)
+
+	// Output:
+	// ....
+	// .#..
+	// ....
+	// 1 false
+}
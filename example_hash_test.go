@@ -0,0 +1,49 @@
+package vec_test
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleHash2() {
+	a := vec.Vec2{1, 0}
+	b := vec.Vec2{1, math.Copysign(0, -1)}
+	fmt.Println("-0 and +0 hash equally:", vec.Hash2(a) == vec.Hash2(b))
+	fmt.Println("Different vectors differ:", vec.Hash2(a) != vec.Hash2(vec.Vec2{0, 1}))
+
+	// Quantized hashing buckets nearby positions into the same cell.
+	p := vec.Vec2{10.01, 4.99}
+	q := vec.Vec2{10.02, 4.98}
+	fmt.Println("Same cell:", vec.HashQuantized2(p, 1) == vec.HashQuantized2(q, 1))
+
+	// Output:
+	// -0 and +0 hash equally: true
+	// Different vectors differ: true
+	// Same cell: true
+}
+
+func ExampleWriteHash2() {
+	// Hash an undirected edge so both orientations collide.
+	seed := maphash.MakeSeed()
+	edgeHash := func(a, b vec.Vec2i) uint64 {
+		if vec.Compare2(a, b) > 0 {
+			a, b = b, a
+		}
+		var h maphash.Hash
+		h.SetSeed(seed)
+		vec.WriteHash2(&h, a)
+		vec.WriteHash2(&h, b)
+		return h.Sum64()
+	}
+	fmt.Println(edgeHash(vec.Vec2i{1, 2}, vec.Vec2i{3, 4}) == edgeHash(vec.Vec2i{3, 4}, vec.Vec2i{1, 2}))
+
+	// Vectors are comparable, so maphash.Comparable works too.
+	fmt.Println(maphash.Comparable(seed, vec.Vec2i{1, 2}) == maphash.Comparable(seed, vec.Vec2i{1, 2}))
+
+	// Output:
+	// true
+	// true
+}
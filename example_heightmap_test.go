@@ -0,0 +1,27 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleHeightmap() {
+	// A 3x3 terrain with a hill in the middle, samples 2 apart.
+	h := vec.NewHeightmap(vec.Vec2i{3, 3}, 2)
+	h.Set(vec.Vec2i{1, 1}, 4)
+
+	fmt.Println(h.HeightAt(vec.Vec2{2, 2}), h.HeightAt(vec.Vec2{1, 2}))
+	fmt.Println(h.GradientAt(vec.Vec2{1, 2}))
+	fmt.Printf("%.2f %.1f\n", h.NormalAt(vec.Vec2{3, 3}), vec.Degrees(h.SlopeAt(vec.Vec2{3, 3})))
+
+	// A ray flying along +X at height 3 hits the hillside.
+	p, t, ok := h.Raycast(vec.Ray3{Origin: vec.Vec3{-1, 2, 3}, Dir: vec.Vec3{1, 0, 0}})
+	fmt.Println(p, t, ok)
+
+	// Output:
+	// 4 2
+	// (2, -1)
+	// (0.58, 0.58, 0.58) 54.7
+	// (1.5, 2, 3) 2.5 true
+}
@@ -0,0 +1,41 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleKDTree2() {
+	points := []vec.Vec2{{0, 0}, {5, 5}, {1, 4}, {9, 1}, {3, 3}}
+	tree := vec.NewKDTree2(points)
+
+	i, d := tree.Nearest(vec.Vec2{4.5, 4})
+	fmt.Println("Nearest:", points[i], "distance²:", d)
+	fmt.Println("Within 2:", len(tree.InRadius(vec.Vec2{4, 4}, 2)))
+
+	// Output:
+	// Nearest: (5, 5) distance²: 1.25
+	// Within 2: 2
+}
+
+func ExampleICP2() {
+	// An L-shaped scan, and the same scan rotated by 5 degrees and shifted.
+	var dst []vec.Vec2
+	for i := range 20 {
+		dst = append(dst, vec.Vec2{float64(i) / 4, 0}, vec.Vec2{0, float64(i) / 2})
+	}
+	src := make([]vec.Vec2, len(dst))
+	for i, p := range dst {
+		src[i] = vec.Rotate2(p, -5*math.Pi/180).Add(vec.Vec2{0.1, -0.1})
+	}
+
+	rot, _, rms := vec.ICP2(src, dst, 50, 1e-9)
+	fmt.Printf("Recovered rotation: %.1f degrees\n", math.Atan2(rot[1][0], rot[0][0])*180/math.Pi)
+	fmt.Printf("Residual below 1e-6: %v\n", rms < 1e-6)
+
+	// Output:
+	// Recovered rotation: 5.0 degrees
+	// Residual below 1e-6: true
+}
@@ -0,0 +1,36 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleTwoBoneIK() {
+	// A leg with 1-unit bones, with the knee bending forward toward +Z.
+	hip := vec.Vec3{0, 2, 0}
+	knee := vec.Vec3{0, 1, 0}
+	foot := vec.Vec3{0, 0, 0}
+
+	knee, foot = vec.TwoBoneIK(hip, knee, foot, vec.Vec3{0, 2 - 1.2, 0}, vec.Vec3{0, 1, 5})
+	fmt.Printf("knee: %.1f, foot: %.1f\n", knee, foot)
+
+	// Output:
+	// knee: (0.0, 1.4, 0.8), foot: (0.0, 0.8, 0.0)
+}
+
+func ExampleFABRIK() {
+	chain := []vec.Vec3{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}, {3, 0, 0}}
+	target := vec.Vec3{1, 2, 0}
+	solved := vec.FABRIK(chain, target, 100, 1e-6)
+
+	fmt.Printf("end: %.3f\n", solved[len(solved)-1])
+	for i := 1; i < len(solved); i++ {
+		fmt.Printf("%.3f ", vec.Len3(solved[i].Sub(solved[i-1])))
+	}
+	fmt.Println()
+
+	// Output:
+	// end: (1.000, 2.000, 0.000)
+	// 1.000 1.000 1.000
+}
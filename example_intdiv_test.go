@@ -0,0 +1,21 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleFloorDiv2() {
+	// Split a position into its 16x16 tile and the offset within the tile.
+	tileSize := vec.Vec2i{16, 16}
+	p := vec.Vec2i{-1, 35}
+	fmt.Println(vec.FloorDiv2(p, tileSize), vec.EuclidMod2(p, tileSize))
+
+	// Go's operators truncate toward zero instead.
+	fmt.Println(p.Div(tileSize))
+
+	// Output:
+	// (-1, 2) (15, 3)
+	// (0, 2)
+}
@@ -0,0 +1,23 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleInterceptPoint() {
+	turret := vec.Vec2{0, 0}
+	ship, shipVel := vec.Vec2{10, 0}, vec.Vec2{0, 3}
+
+	aim, t, ok := vec.InterceptPoint(turret, 5, ship, shipVel)
+	fmt.Printf("%v %.2f %.2f\n", ok, aim, t)
+
+	// A target faster than the shot, moving away, cannot be caught.
+	_, _, ok = vec.InterceptPoint(turret, 5, ship, vec.Vec2{6, 0})
+	fmt.Println(ok)
+
+	// Output:
+	// true (10.00, 7.50) 2.50
+	// false
+}
@@ -0,0 +1,81 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleIntersectCircles() {
+	a := vec.Circle{Center: vec.Vec2{0, 0}, Radius: 5}
+	b := vec.Circle{Center: vec.Vec2{8, 0}, Radius: 5}
+	p1, p2, n := vec.IntersectCircles(a, b)
+	fmt.Println(n, p1, p2)
+
+	// Touching circles meet at a single point.
+	c := vec.Circle{Center: vec.Vec2{10, 0}, Radius: 5}
+	p1, _, n = vec.IntersectCircles(a, c)
+	fmt.Println(n, p1)
+
+	// Output:
+	// 2 (4, 3) (4, -3)
+	// 1 (5, 0)
+}
+
+func ExampleIntersectCircleSegment() {
+	c := vec.Circle{Center: vec.Vec2{0, 0}, Radius: 5}
+
+	line := vec.Line2{Point: vec.Vec2{-10, 3}, Dir: vec.Vec2{1, 0}}
+	p1, p2, n := vec.IntersectCircleLine(c, line)
+	fmt.Println("Line:", n, p1, p2)
+
+	// The segment starts inside the circle, so it crosses only once.
+	seg := vec.Segment2{A: vec.Vec2{0, 3}, B: vec.Vec2{10, 3}}
+	p1, _, n = vec.IntersectCircleSegment(c, seg)
+	fmt.Println("Segment:", n, p1)
+
+	// Output:
+	// Line: 2 (-4, 3) (4, 3)
+	// Segment: 1 (4, 3)
+}
+
+func ExampleIntersectSegments2() {
+	a := vec.Segment2{A: vec.Vec2{0, 0}, B: vec.Vec2{4, 4}}
+	b := vec.Segment2{A: vec.Vec2{0, 4}, B: vec.Vec2{4, 0}}
+	fmt.Println(vec.IntersectSegments2(a, b))
+
+	// The lines cross, but beyond the end of c.
+	c := vec.Segment2{A: vec.Vec2{0, 4}, B: vec.Vec2{1, 3}}
+	fmt.Println(vec.IntersectSegments2(a, c))
+
+	// Collinear segments sharing the stretch from (2, 2) to (4, 4).
+	d := vec.Segment2{A: vec.Vec2{6, 6}, B: vec.Vec2{2, 2}}
+	fmt.Println(vec.IntersectSegments2(a, d))
+
+	// Output:
+	// (2, 2) 0.5 0.5 Crossing
+	// (2, 2) 0.5 2 Disjoint
+	// (2, 2) 0.5 1 Overlapping
+}
+
+func ExampleIntersectRaySegment2() {
+	wall := vec.Segment2{A: vec.Vec2{2, -1}, B: vec.Vec2{2, 3}}
+	ray := vec.Ray2{Origin: vec.Vec2{0, 0}, Dir: vec.Vec2{1, 0.5}}
+	p, t, u, kind := vec.IntersectRaySegment2(ray, wall)
+	fmt.Println(p, t, u, kind)
+
+	// Pointing away, the ray misses even though its line hits the wall.
+	ray.Dir = ray.Dir.Neg()
+	_, _, _, kind = vec.IntersectRaySegment2(ray, wall)
+	fmt.Println(kind)
+
+	// Output:
+	// (2, 1) 2 0.5 Crossing
+	// Disjoint
+}
+
+func ExampleIntersectionKind_String() {
+	fmt.Println(vec.Crossing, vec.Overlapping, vec.IntersectionKind(9))
+	// Output:
+	// Crossing Overlapping IntersectionKind(9)
+}
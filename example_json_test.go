@@ -0,0 +1,58 @@
+package vec_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func Example_json() {
+	type Player struct {
+		Pos vec.Vec2  `json:"pos"`
+		Dir vec.Vec3i `json:"dir"`
+	}
+	p := Player{vec.Vec2{1.5, 2}, vec.Vec3i{0, 1, 0}}
+
+	b, _ := json.Marshal(p)
+	fmt.Println(string(b))
+
+	vec.JSONEncoding = vec.JSONObject
+	b, _ = json.Marshal(p)
+	fmt.Println(string(b))
+	vec.JSONEncoding = vec.JSONArray
+
+	// Both forms decode regardless of the setting.
+	var q Player
+	err := json.Unmarshal([]byte(`{"pos": {"x": 3, "y": 4}, "dir": [1, 0, 0]}`), &q)
+	fmt.Println(q.Pos, q.Dir, err)
+
+	// null leaves the field as it was.
+	err = json.Unmarshal([]byte(`{"pos": null}`), &q)
+	fmt.Println(q.Pos, err)
+
+	err = json.Unmarshal([]byte(`{"pos": [1, 2, 3]}`), &q)
+	fmt.Println(err)
+
+	// Output:
+	// {"pos":[1.5,2],"dir":[0,1,0]}
+	// {"pos":{"x":1.5,"y":2},"dir":{"x":0,"y":1,"z":0}}
+	// (3, 4) (1, 0, 0) <nil>
+	// (3, 4) <nil>
+	// vec: expected 2 components in JSON array, got 3
+}
+
+func Example_jsonMapKeys() {
+	// Vectors serialize with MarshalText when used as map keys.
+	tiles := map[vec.Vec2i]string{{0, 0}: "grass", {3, -1}: "water"}
+	b, _ := json.Marshal(tiles)
+	fmt.Println(string(b))
+
+	var back map[vec.Vec2i]string
+	err := json.Unmarshal(b, &back)
+	fmt.Println(back[vec.Vec2i{3, -1}], err)
+
+	// Output:
+	// {"0,0":"grass","3,-1":"water"}
+	// water <nil>
+}
@@ -0,0 +1,37 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleRectg_SplitH() {
+	screen := vec.Recti{Max: vec.Vec2i{800, 600}}
+	body := screen.Pad(vec.UniformInsets(10))
+	cols := body.SplitH(1, 3)
+	fmt.Println(cols[0], cols[1])
+
+	header := cols[1].SplitV(1, 9)[0]
+	fmt.Println(header)
+
+	// Output:
+	// {(10, 10) (205, 590)} {(205, 10) (790, 590)}
+	// {(205, 10) (790, 68)}
+}
+
+func ExampleRectg_FitAspect() {
+	// Letterbox a 16:9 game screen in a 4:3 window.
+	window := vec.Recti{Max: vec.Vec2i{1024, 768}}
+	fmt.Println(window.FitAspect(vec.Vec2i{16, 9}))
+
+	// Put a 100x40 button in the bottom-right corner, with Y pointing down.
+	button := vec.Recti{Max: vec.Vec2i{100, 40}}
+	fmt.Println(button.AlignTo(window, vec.Vec2{1, 1}))
+	fmt.Println(window.Anchor(vec.Vec2{0.5, 0}))
+
+	// Output:
+	// {(0, 96) (1024, 672)}
+	// {(924, 728) (1024, 768)}
+	// (512, 0)
+}
@@ -0,0 +1,32 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleLookRotation() {
+	// Turn a turret, which faces -Z, toward an enemy on its right: a
+	// quarter turn clockwise about +Y.
+	q := vec.LookRotation(vec.Vec3{1, 0, 0}, vec.Vec3{0, 1, 0})
+	axis, angle := q.AxisAngle()
+	fmt.Printf("%.3f %.1f\n", axis, vec.Degrees(angle))
+
+	// Output:
+	// (0.000, -1.000, 0.000) 90.0
+}
+
+func ExampleBillboardCylindrical() {
+	// A tree sprite at the origin stays upright while facing the camera.
+	m := vec.BillboardCylindrical(vec.Vec3{}, vec.Vec3{3, 10, 4}, vec.Vec3{0, 1, 0})
+	fmt.Println(m.MulVec(vec.Vec4{0, 0, 1, 0}), m.MulVec(vec.Vec4{0, 1, 0, 0}))
+
+	// A particle sprite faces the camera squarely.
+	m = vec.BillboardSpherical(vec.Vec3{}, vec.Vec3{0, 3, 4}, vec.Vec3{0, 1, 0})
+	fmt.Println(m.MulVec(vec.Vec4{0, 0, 1, 0}))
+
+	// Output:
+	// (0.6, 0, 0.8, 0) (0, 1, 0, 0)
+	// (0, 0.6, 0.8, 0)
+}
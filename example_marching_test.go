@@ -0,0 +1,66 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleMarchingCubes() {
+	// Sample a ball of radius 6 centered in a 16³ grid.
+	g := vec.NewGrid3[float64](vec.Vec3i{16, 16, 16})
+	center := vec.Vec3{7.5, 7.5, 7.5}
+	for z := range 16 {
+		for y := range 16 {
+			for x := range 16 {
+				p := vec.Vec3{float64(x), float64(y), float64(z)}
+				g.Set(vec.Vec3i{x, y, z}, 6-vec.Len3(p.Sub(center)))
+			}
+		}
+	}
+	mesh := vec.MarchingCubes(g, 0)
+
+	// The mesh is closed: every directed edge has a matching opposite edge.
+	edges := make(map[[2]int]int)
+	for i := 0; i < len(mesh.Indices); i += 3 {
+		for j := range 3 {
+			a, b := mesh.Indices[i+j], mesh.Indices[i+(j+1)%3]
+			edges[[2]int{a, b}]++
+			edges[[2]int{b, a}]--
+		}
+	}
+	closed := true
+	for _, n := range edges {
+		closed = closed && n == 0
+	}
+	fmt.Println("Closed:", closed)
+
+	// Outward-facing triangles give a positive enclosed volume, close to 4/3·π·6³ ≈ 905.
+	var volume float64
+	for i := range mesh.NumTriangles() {
+		a, b, c := mesh.Triangle(i)
+		volume += vec.Dot3(a.Sub(center), vec.Cross3(b.Sub(center), c.Sub(center))) / 6
+	}
+	fmt.Printf("Volume: %.0f\n", volume)
+
+	// Output:
+	// Closed: true
+	// Volume: 890
+}
+
+func ExampleMarchingSquares() {
+	// A disc of radius 5 with a hole of radius 2.
+	field := func(p vec.Vec2i) float64 {
+		d := vec.Len2(vec.As2[float64](p))
+		return min(5-d, d-2)
+	}
+	bounds := vec.Recti{Min: vec.Vec2i{-8, -8}, Max: vec.Vec2i{9, 9}}
+	for _, path := range vec.MarchingSquares(field, 0, bounds) {
+		area := vec.Polygon(path[:len(path)-1]).SignedArea()
+		fmt.Printf("closed: %v, length: %.1f, signed area: %.1f\n", path.Closed(), path.Length(), area)
+	}
+
+	// Output:
+	// closed: true, length: 31.3, signed area: 77.8
+	// closed: true, length: 12.4, signed area: -11.9
+}
@@ -0,0 +1,37 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleComputeTangents() {
+	// A quad in the XY plane facing +Z, with its texture mirrored along U.
+	positions := []vec.Vec3{{0, 0, 0}, {1, 0, 0}, {1, 1, 0}, {0, 1, 0}}
+	uvs := []vec.Vec2{{1, 0}, {0, 0}, {0, 1}, {1, 1}}
+	indices := []int{0, 1, 2, 0, 2, 3}
+
+	for _, t := range vec.ComputeTangents(positions, uvs, indices)[:2] {
+		fmt.Println(t)
+	}
+
+	// Output:
+	// (-1, 0, 0, -1)
+	// (-1, 0, 0, -1)
+}
+
+func ExampleComputeNormals() {
+	// Two triangles folded along the Y axis, one facing +Z and one facing -X.
+	positions := []vec.Vec3{{0, 0, 0}, {0, 1, 0}, {1, 0, 0}, {0, 0, -1}}
+	indices := []int{0, 2, 1, 0, 1, 3}
+
+	fmt.Println(vec.ComputeFlatNormals(positions, indices))
+	fmt.Printf("%.3f\n", vec.ComputeNormals(positions, indices))
+	fmt.Println(vec.FaceNormal(positions[0], positions[2], positions[1]))
+
+	// Output:
+	// [(0, 0, 1) (-1, 0, 0)]
+	// [(-0.707, 0.000, 0.707) (-0.707, 0.000, 0.707) (0.000, 0.000, 1.000) (-1.000, 0.000, 0.000)]
+	// (0, 0, 1)
+}
@@ -0,0 +1,49 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleMoveAndSlide() {
+	up := vec.Vec2{0, 1}
+	world := []vec.Shape2{
+		vec.Rect{Min: vec.Vec2{-100, -1}, Max: vec.Vec2{100, 0}}, // floor
+		vec.Rect{Min: vec.Vec2{10, 0}, Max: vec.Vec2{11, 20}},    // wall
+	}
+	player := vec.Rect{Min: vec.Vec2{-0.5, 0}, Max: vec.Vec2{0.5, 2}}
+
+	// Falling diagonally: land on the floor and slide right.
+	res := vec.MoveAndSlide(vec.Vec2{0, 3}, vec.Vec2{4, -6}, player, world, 4)
+	fmt.Printf("pos=%.3f vel=%.3f ground=%v wall=%v\n", res.Pos, res.Vel, res.OnGround(up), res.OnWall(up))
+
+	// Walking into the wall stops at its face.
+	res = vec.MoveAndSlide(vec.Vec2{8, 0.001}, vec.Vec2{5, 0}, player, world, 4)
+	fmt.Printf("pos=%.3f vel=%.3f ground=%v wall=%v\n", res.Pos, res.Vel, res.OnGround(up), res.OnWall(up))
+
+	// Output:
+	// pos=(4.000, 0.000) vel=(4.000, 0.000) ground=true wall=false
+	// pos=(9.500, 0.001) vel=(0.000, 0.000) ground=false wall=true
+}
+
+func ExampleMoveAndSlide_maxSlides() {
+	up := vec.Vec2{0, 1}
+	world := []vec.Shape2{
+		vec.Rect{Min: vec.Vec2{-100, -1}, Max: vec.Vec2{100, 0}}, // floor
+		vec.Rect{Min: vec.Vec2{10, 0}, Max: vec.Vec2{11, 20}},    // wall
+	}
+	ball := vec.Circle{Radius: 0.5}
+
+	// With no slides the ball stops where it first touches the wall.
+	res := vec.MoveAndSlide(vec.Vec2{1, 5}, vec.Vec2{10, 0}, ball, world, 0)
+	fmt.Printf("pos=%.3f vel=%.3f wall=%v\n", res.Pos, res.Vel, res.OnWall(up))
+
+	// One slide along the floor, then the ball stops in the corner.
+	res = vec.MoveAndSlide(vec.Vec2{7, 2}, vec.Vec2{6, -6}, ball, world, 1)
+	fmt.Printf("pos=%.3f vel=%.3f ground=%v wall=%v\n", res.Pos, res.Vel, res.OnGround(up), res.OnWall(up))
+
+	// Output:
+	// pos=(9.500, 5.000) vel=(0.000, 0.000) wall=true
+	// pos=(9.500, 0.500) vel=(0.000, 0.000) ground=true wall=true
+}
@@ -0,0 +1,29 @@
+package vec_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleNeighbors4() {
+	fmt.Println(vec.Neighbors4(vec.Vec2i{5, 5}))
+	fmt.Println(len(slices.Collect(vec.NeighborsRange(vec.Vec2i{0, 0}, 2))))
+
+	// Output:
+	// [(6, 5) (5, 6) (4, 5) (5, 4)]
+	// 24
+}
+
+func ExampleHexNeighbors() {
+	h := vec.Vec2i{0, 0}
+	fmt.Println(vec.HexNeighbors(h))
+	fmt.Println(vec.HexDistance(h, vec.Vec2i{3, -1}))
+	fmt.Println(len(slices.Collect(vec.HexNeighborsRange(h, 2))))
+
+	// Output:
+	// [(1, 0) (1, -1) (0, -1) (-1, 0) (-1, 1) (0, 1)]
+	// 3
+	// 18
+}
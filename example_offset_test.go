@@ -0,0 +1,22 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleOffsetPolygon() {
+	// An L-shaped room.
+	room := vec.Polygon{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+
+	// Keep agents 0.25 away from the walls.
+	fmt.Println(vec.OffsetPolygon(room, -0.25, vec.JoinMiter))
+
+	// Outline the room with beveled corners.
+	fmt.Println(vec.OffsetPolygon(room, 0.5, vec.JoinBevel))
+
+	// Output:
+	// [(0.25, 0.25) (1.75, 0.25) (1.75, 0.75) (0.75, 0.75) (0.75, 1.75) (0.25, 1.75)]
+	// [(-0.5, 0) (0, -0.5) (2, -0.5) (2.5, 0) (2.5, 1) (2, 1.5) (1.5, 1.5) (1.5, 2) (1, 2.5) (0, 2.5) (-0.5, 2)]
+}
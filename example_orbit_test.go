@@ -0,0 +1,38 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleOrbitAround() {
+	sun := vec.Vec2{0, 0}
+	planet := vec.PointOnCircle(sun, 10, 0)
+
+	// A quarter turn each second, stepped at 4 frames per second.
+	for range 4 {
+		planet = vec.OrbitAround(planet, sun, math.Pi/2, 0.25)
+	}
+	fmt.Printf("%.1f\n", vec.Degrees(vec.AngleAround(planet, sun)))
+
+	vel := vec.TangentialVelocity(planet, sun, math.Pi/2)
+	fmt.Printf("%.2f %.2f\n", vec.Len2(vel), vec.AngularVelocity(planet, sun, vel))
+
+	// Output:
+	// 90.0
+	// 15.71 1.57
+}
+
+func ExamplePointOnEllipse() {
+	for _, deg := range []float64{0, 90, 180} {
+		p := vec.PointOnEllipse(vec.Vec2{1, 1}, vec.Vec2{4, 2}, vec.Radians(deg))
+		fmt.Printf("%.1f\n", p)
+	}
+
+	// Output:
+	// (5.0, 1.0)
+	// (1.0, 3.0)
+	// (-3.0, 1.0)
+}
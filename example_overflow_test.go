@@ -0,0 +1,23 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleCheckedAdd2() {
+	chunk := vec.Vec2i{math.MaxInt - 1, 0}
+	_, ok := vec.CheckedAdd2(chunk, vec.Vec2i{2, 0})
+	fmt.Println(ok)
+
+	tile := vec.Vec2g[int16]{32000, -32000}
+	fmt.Println(vec.SaturatingAdd2(tile, vec.Vec2g[int16]{1000, -1000}))
+	fmt.Println(vec.WrappingAdd2(tile, vec.Vec2g[int16]{1000, -1000}))
+
+	// Output:
+	// false
+	// (32767, -32768)
+	// (-32536, 32536)
+}
@@ -0,0 +1,46 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExamplePackHalf3() {
+	h := vec.PackHalf3(vec.Vec3{1, -2.5, 0.1})
+	fmt.Printf("%04x\n", h)
+	fmt.Println(vec.UnpackHalf3(h))
+
+	// Output:
+	// [3c00 c100 2e66]
+	// (1, -2.5, 0.099975586)
+}
+
+func ExamplePackUnorm4x8() {
+	red := vec.Vec4{1, 0, 0, 0.5}
+	p := vec.PackUnorm4x8(red)
+	fmt.Printf("%08x %.3f\n", p, vec.UnpackUnorm4x8(p))
+
+	n := vec.PackSnorm2x16(vec.Vec2{-1, 0.5})
+	fmt.Printf("%08x %.3f\n", n, vec.UnpackSnorm2x16(n))
+
+	fmt.Printf("%08x\n", vec.PackUnorm1010102(vec.Vec4{1, 0, 1, 1}))
+
+	// Output:
+	// 800000ff (1.000, 0.000, 0.000, 0.502)
+	// 40008001 (-1.000, 0.500)
+	// fff003ff
+}
+
+func ExampleEncodeOct() {
+	n := vec.Normalize3(vec.Vec3{1, -2, -2})
+	e := vec.EncodeOct(n)
+	fmt.Printf("%.3f %.3f\n", e, vec.DecodeOct(e))
+
+	p := vec.PackOct16(n)
+	fmt.Printf("%04x %.2f\n", p, vec.UnpackOct16(p))
+
+	// Output:
+	// (0.600, -0.800) (0.333, -0.667, -0.667)
+	// 9a4c (0.33, -0.67, -0.67)
+}
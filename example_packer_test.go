@@ -0,0 +1,26 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExamplePackRects() {
+	sprites := []vec.Vec2i{{32, 16}, {16, 32}, {32, 32}, {16, 16}, {32, 48}, {64, 32}}
+	// The 64x32 sprite does not fit in what is left.
+	placements, ok := vec.PackRects(vec.Vec2i{64, 64}, sprites)
+	for _, r := range placements {
+		fmt.Println(r)
+	}
+	fmt.Println(ok)
+
+	// Output:
+	// {(0, 48) (32, 64)}
+	// {(32, 32) (48, 64)}
+	// {(32, 0) (64, 32)}
+	// {(48, 32) (64, 48)}
+	// {(0, 0) (32, 48)}
+	// {(0, 0) (0, 0)}
+	// false
+}
@@ -0,0 +1,50 @@
+package vec_test
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleParse2() {
+	for _, s := range []string{"3,4", "3 4", "(3, 4)", "[-1.5, 2e3]"} {
+		v, err := vec.Parse2[float64](s)
+		fmt.Println(v, err)
+	}
+
+	// Integer targets reject fractions and out-of-range values.
+	_, err := vec.Parse2[int]("1.5, 2")
+	fmt.Println(err)
+	_, err = vec.Parse3[uint8]("1, 2, 300")
+	fmt.Println(err)
+
+	// Output:
+	// (3, 4) <nil>
+	// (3, 4) <nil>
+	// (3, 4) <nil>
+	// (-1.5, 2000) <nil>
+	// vec: parsing "1.5, 2": strconv.ParseInt: parsing "1.5": invalid syntax
+	// vec: parsing "1, 2, 300": strconv.ParseUint: parsing "300": value out of range
+}
+
+func ExampleVec2g_UnmarshalText() {
+	var v vec.Vec2i
+	err := v.UnmarshalText([]byte("(800, 600)"))
+	fmt.Println(v, err)
+
+	// Output:
+	// (800, 600) <nil>
+}
+
+func ExampleVec2g_Set() {
+	fs := flag.NewFlagSet("game", flag.ContinueOnError)
+	size := vec.Vec2i{640, 480}
+	fs.Var(&size, "size", "window size")
+
+	err := fs.Parse([]string{"-size=800,600"})
+	fmt.Println(size, err)
+
+	// Output:
+	// (800, 600) <nil>
+}
@@ -0,0 +1,32 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExamplePluckerLine() {
+	x := vec.PluckerFromPoints(vec.Vec3{0, 0, 0}, vec.Vec3{1, 0, 0})
+	above := vec.PluckerFromPoints(vec.Vec3{0, 0, 2}, vec.Vec3{0, 1, 2})
+	below := vec.PluckerFromPoints(vec.Vec3{0, 0, -2}, vec.Vec3{0, 1, -2})
+	meets := vec.PluckerFromPoints(vec.Vec3{3, 0, 0}, vec.Vec3{3, 1, 0})
+	fmt.Println(x.Side(above), x.Side(below), x.Side(meets))
+	fmt.Println(x.Distance(above))
+	// Output:
+	// -2 2 0
+	// 2
+}
+
+func ExamplePluckerLine_CrossesTriangle() {
+	a, b, c := vec.Vec3{0, 0, 0}, vec.Vec3{1, 0, 0}, vec.Vec3{0, 1, 0}
+	// A ray straight down through the diagonal edge shared with the
+	// triangle on the other side still crosses both.
+	r := vec.PluckerFromRay(vec.Ray3{Origin: vec.Vec3{0.5, 0.5, 1}, Dir: vec.Vec3{0, 0, -1}})
+	fmt.Println(r.CrossesTriangle(a, b, c), r.CrossesTriangle(b, vec.Vec3{1, 1, 0}, c))
+	miss := vec.PluckerFromRay(vec.Ray3{Origin: vec.Vec3{2, 2, 1}, Dir: vec.Vec3{0, 0, -1}})
+	fmt.Println(miss.CrossesTriangle(a, b, c))
+	// Output:
+	// true true
+	// false
+}
@@ -0,0 +1,35 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleOrient2D() {
+	// p lies a few ulps to the left of the line through b and c, which the
+	// naive cross product gets wrong.
+	p := vec.Vec2{0.5 + 41*0x1p-53, 0.5 + 48*0x1p-53}
+	b, c := vec.Vec2{12, 12}, vec.Vec2{24, 24}
+	fmt.Println(vec.Cross2(b.Sub(p), c.Sub(p)) > 0)
+	fmt.Println(vec.Orient2D(p, b, c) > 0)
+
+	fmt.Println(vec.Orient2D(vec.Vec2{1, 1}, vec.Vec2{2, 2}, vec.Vec2{3, 3}))
+
+	// Output:
+	// false
+	// true
+	// 0
+}
+
+func ExampleInCircle() {
+	a, b, c := vec.Vec2{5, 0}, vec.Vec2{0, 5}, vec.Vec2{-5, 0}
+	fmt.Println(vec.InCircle(a, b, c, vec.Vec2{0, 0}) > 0)
+	fmt.Println(vec.InCircle(a, b, c, vec.Vec2{3, -4}))
+	fmt.Println(vec.InCircle(a, b, c, vec.Vec2{6, 6}) < 0)
+
+	// Output:
+	// true
+	// 0
+	// true
+}
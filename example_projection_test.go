@@ -0,0 +1,59 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleProject() {
+	view := vec.LookAt(vec.Vec3{0, 0, 10}, vec.Vec3{}, vec.Vec3{0, 1, 0})
+	proj := vec.Perspective(math.Pi/2, 4.0/3, 1, 100)
+	viewProj := proj.Mul(view)
+	viewport := vec.Rect{Max: vec.Vec2{640, 480}}
+
+	// The target is at the center of the screen, and points above it
+	// appear higher up, at smaller screen Y.
+	fmt.Printf("%.1f\n", vec.Project(vec.Vec3{}, viewProj, viewport).Vec2())
+	s := vec.Project(vec.Vec3{0, 5, 0}, viewProj, viewport)
+	fmt.Printf("%.1f\n", s.Vec2())
+
+	p, _ := vec.Unproject(s, viewProj, viewport)
+	fmt.Printf("%.3f\n", p)
+
+	// Output:
+	// (320.0, 240.0)
+	// (320.0, 120.0)
+	// (0.000, 5.000, 0.000)
+}
+
+func ExampleMat4_Inverse() {
+	m := vec.LookAt(vec.Vec3{1, 2, 3}, vec.Vec3{}, vec.Vec3{0, 1, 0})
+	inv, _ := m.Inverse()
+	fmt.Printf("%.3f\n", inv.MulVec(vec.Vec4{0, 0, 0, 1}))
+
+	// Output:
+	// (1.000, 2.000, 3.000, 1.000)
+}
+
+func ExampleScreenPointToRay() {
+	view := vec.LookAt(vec.Vec3{0, 0, 10}, vec.Vec3{}, vec.Vec3{0, 1, 0})
+	proj := vec.Perspective(math.Pi/2, 4.0/3, 1, 100)
+	inv, _ := proj.Mul(view).Inverse()
+	viewport := vec.Rect{Max: vec.Vec2{640, 480}}
+
+	// Clicking the center of the screen picks along the view direction.
+	r := vec.ScreenPointToRay(vec.Vec2{320, 240}, viewport, inv)
+	fmt.Printf("%.3f %.3f\n", r.Origin, r.Dir)
+
+	// Clicking higher up picks above the target, here where the ray
+	// crosses the plane Z = 0.
+	r = vec.ScreenPointToRay(vec.Vec2{320, 120}, viewport, inv)
+	t := -r.Origin.Z / r.Dir.Z
+	fmt.Printf("%.3f\n", r.Origin.Add(r.Dir.Scale(t)))
+
+	// Output:
+	// (0.000, 0.000, 9.000) (0.000, 0.000, -1.000)
+	// (0.000, 5.000, 0.000)
+}
@@ -0,0 +1,41 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleQuat() {
+	q := vec.QuatFromAxisAngle(vec.Vec3{0, 0, 1}, math.Pi/2)
+	fmt.Printf("%.3f\n", q.Rotate(vec.Vec3{1, 0, 0}))
+
+	axis, angle := q.Mul(q).AxisAngle()
+	fmt.Printf("%.3f %.3f\n", axis, angle)
+
+	half := vec.SlerpQuat(vec.IdentityQuat(), q, 0.5)
+	fmt.Printf("%.3f\n", half)
+
+	// Output:
+	// (0.000, 1.000, 0.000)
+	// (0.000, 0.000, 1.000) 3.142
+	// (0.000, 0.000, 0.383, 0.924)
+}
+
+func ExampleSwingTwist() {
+	// Tilt by 30° about X after twisting by 90° about Y.
+	twist := vec.QuatFromAxisAngle(vec.Vec3{0, 1, 0}, math.Pi/2)
+	tilt := vec.QuatFromAxisAngle(vec.Vec3{1, 0, 0}, math.Pi/6)
+	q := tilt.Mul(twist)
+
+	s, t := vec.SwingTwist(q, vec.Vec3{0, 1, 0})
+	_, swingAngle := s.AxisAngle()
+	twistAxis, twistAngle := t.AxisAngle()
+	fmt.Printf("swing: %.0f°\n", swingAngle*180/math.Pi)
+	fmt.Printf("twist: %.0f° about %.0f\n", twistAngle*180/math.Pi, twistAxis)
+
+	// Output:
+	// swing: 30°
+	// twist: 90° about (0, 1, 0)
+}
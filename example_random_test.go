@@ -0,0 +1,80 @@
+package vec_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleRandInPolygon() {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	// An L-shaped polygon made of three unit squares.
+	poly := vec.Polygon{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+	fmt.Println("Area:", poly.Area())
+
+	inside := 0
+	for range 1000 {
+		p := vec.RandInPolygon(poly, r)
+		if p.X >= 0 && p.Y >= 0 && p.X <= 2 && p.Y <= 2 && (p.X <= 1 || p.Y <= 1) {
+			inside++
+		}
+	}
+	fmt.Println("Inside:", inside)
+
+	// Output:
+	// Area: 3
+	// Inside: 1000
+}
+
+func ExampleRandOnMesh() {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	// A unit quad on the XY plane facing +Z.
+	mesh := vec.Mesh{
+		Positions: []vec.Vec3{{0, 0, 0}, {1, 0, 0}, {1, 1, 0}, {0, 1, 0}},
+		Indices:   []int{0, 1, 2, 0, 2, 3},
+	}
+	p, n := vec.RandOnMesh(mesh, r)
+	fmt.Println("On plane:", p.Z == 0)
+	fmt.Println("Normal:", n)
+
+	// Output:
+	// On plane: true
+	// Normal: (0, 0, 1)
+}
+
+func ExampleRandInShape() {
+	r := rand.New(rand.NewPCG(1, 2))
+	pill := vec.Capsule2{A: vec.Vec2{0, 0}, B: vec.Vec2{4, 0}, Radius: 1}
+
+	inside := 0
+	for range 1000 {
+		if p, ok := vec.RandInShape(pill, r); ok && pill.ContainsPoint(p) {
+			inside++
+		}
+	}
+	fmt.Println("Inside:", inside)
+
+	// A shape without area has no points to sample.
+	_, ok := vec.RandInShape(vec.Circle{Center: vec.Vec2{1, 1}}, r)
+	fmt.Println(ok)
+
+	// Output:
+	// Inside: 1000
+	// false
+}
+
+func ExampleSampleOnPath() {
+	path := vec.Path2{{0, 0}, {3, 0}, {3, 1}}
+	for _, t := range []float64{0, 0.5, 0.875, 2} {
+		fmt.Println(vec.SampleOnPath(path, t))
+	}
+
+	// Output:
+	// (0, 0)
+	// (2, 0)
+	// (3, 0.5)
+	// (3, 1)
+}
@@ -0,0 +1,24 @@
+package vec_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleLinePoints() {
+	fmt.Println(slices.Collect(vec.LinePoints(vec.Vec2i{0, 0}, vec.Vec2i{4, 2})))
+	fmt.Println(slices.Collect(vec.SupercoverLinePoints(vec.Vec2i{0, 0}, vec.Vec2i{2, 1})))
+
+	// Output:
+	// [(0, 0) (1, 1) (2, 1) (3, 2) (4, 2)]
+	// [(0, 0) (1, 0) (1, 1) (2, 1)]
+}
+
+func ExampleCirclePoints() {
+	fmt.Println(slices.Collect(vec.CirclePoints(vec.Vec2i{0, 0}, 2)))
+
+	// Output:
+	// [(2, 0) (2, 1) (1, 2) (0, 2) (-1, 2) (-2, 1) (-2, 0) (-2, -1) (-1, -2) (0, -2) (1, -2) (2, -1)]
+}
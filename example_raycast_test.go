@@ -0,0 +1,30 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleRaycast2() {
+	world := []vec.Shape2{
+		vec.Segment2{A: vec.Vec2{10, -5}, B: vec.Vec2{10, 5}},
+		vec.Circle{Center: vec.Vec2{6, 0}, Radius: 1},
+		vec.Rect{Min: vec.Vec2{2, 2}, Max: vec.Vec2{4, 4}},
+		vec.Polygon{{14, -1}, {16, 0}, {14, 1}},
+	}
+	ray := vec.Ray2{Origin: vec.Vec2{0, 0}, Dir: vec.Vec2{1, 0}}
+
+	hit, ok := vec.Raycast2(ray, world)
+	fmt.Println(ok, hit.Index, hit.Point, hit.Normal, hit.Distance)
+
+	for _, h := range vec.RaycastAll2(ray, world) {
+		fmt.Println(h.Index, h.Point, h.Normal)
+	}
+
+	// Output:
+	// true 1 (5, 0) (-1, 0) 5
+	// 1 (5, 0) (-1, 0)
+	// 0 (10, 0) (-1, 0)
+	// 3 (14, 0) (-1, 0)
+}
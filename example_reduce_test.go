@@ -0,0 +1,43 @@
+package vec_test
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleReduce3() {
+	v := vec.Vec3{2, -7, 3}
+	fmt.Println(vec.Reduce3(v, "", func(acc string, c float64) string {
+		return acc + fmt.Sprintf("[%v]", c)
+	}))
+	fmt.Println(vec.ComponentSum3(v), vec.ComponentProduct3(v))
+	fmt.Println(vec.MinComponent3(v), vec.MaxComponent3(v))
+	fmt.Println(vec.ArgMin3(v), vec.ArgMax3(v))
+
+	// Works with other vector types too, such as the area of an image size.
+	fmt.Println(vec.ComponentProduct2(image.Pt(640, 480)))
+
+	// Output:
+	// [2][-7][3]
+	// -2 -42
+	// -7 3
+	// 1 2
+	// 307200
+}
+
+func ExampleZipWith3() {
+	// Per-component clamp, written with a three-input zip.
+	v := vec.Vec3{-2, 0.5, 9}
+	lo := vec.Vec3{0, 0, 0}
+	hi := vec.Vec3{1, 1, 5}
+	fmt.Println(vec.ZipWith3(v, lo, hi, func(x, l, h float64) float64 { return min(max(x, l), h) }))
+
+	// Scale and offset in one step.
+	fmt.Println(vec.MulAdd3(vec.Vec3{1, 2, 3}, vec.Vec3{2, 2, 2}, vec.Vec3{10, 20, 30}))
+
+	// Output:
+	// (0, 0.5, 5)
+	// (12, 24, 36)
+}
@@ -0,0 +1,36 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleCapsule2() {
+	// A standing character collider, 2 wide and 4 tall.
+	player := vec.Capsule2{A: vec.Vec2{0, 1}, B: vec.Vec2{0, 3}, Radius: 1}
+	fmt.Println(player.ContainsPoint(vec.Vec2{0.5, 3.5}), player.ContainsPoint(vec.Vec2{0.9, 3.9}))
+	fmt.Println(player.ClosestPoint(vec.Vec2{3, 2}))
+
+	floor := vec.Segment2{A: vec.Vec2{-5, 0}, B: vec.Vec2{5, 0}}
+	fmt.Println(player.IntersectsSegment(floor))
+
+	// Output:
+	// true false
+	// (1, 2)
+	// true
+}
+
+func ExampleRoundedRect() {
+	button := vec.RoundedRect{Rect: vec.Rect{Max: vec.Vec2{100, 40}}, Radius: 10}
+
+	// The rounding cuts off the corners.
+	fmt.Println(button.ContainsPoint(vec.Vec2{50, 1}), button.ContainsPoint(vec.Vec2{1, 1}))
+
+	cursor := vec.Circle{Center: vec.Vec2{-3, -3}, Radius: 5}
+	fmt.Println(button.IntersectsCircle(cursor))
+
+	// Output:
+	// true false
+	// false
+}
@@ -0,0 +1,36 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleSmoothMin() {
+	// Far apart, SmoothMin is just min; close together it dips below both.
+	fmt.Println(vec.SmoothMin(1.0, 5.0, 1))
+	fmt.Println(vec.SmoothMin(1.0, 1.0, 1))
+	fmt.Println(vec.SmoothMax(1.0, 1.0, 1))
+
+	a := vec.Vec2{0, 3}
+	b := vec.Vec2{0.5, 0}
+	fmt.Println(vec.SmoothMin2(a, b, 1))
+
+	// Output:
+	// 1
+	// 0.75
+	// 1.25
+	// (-0.0625, 0)
+}
+
+func ExampleSoftClamp() {
+	for _, v := range []float64{5, 9, 10, 100} {
+		fmt.Printf("%.4f\n", vec.SoftClamp(v, 0, 10, 2))
+	}
+
+	// Output:
+	// 5.0000
+	// 8.7869
+	// 9.2642
+	// 10.0000
+}
@@ -0,0 +1,27 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleRaymarch() {
+	// A unit sphere at (0, 0, -5) in front of a camera looking along -Z.
+	scene := func(p vec.Vec3) float64 { return vec.Len3(p.Sub(vec.Vec3{0, 0, -5})) - 1 }
+
+	ray := vec.Ray3{Origin: vec.Vec3{0.5, 0, 0}, Dir: vec.Vec3{0, 0, -1}}
+	p, t, ok := vec.Raymarch(scene, ray, 64)
+	fmt.Printf("%v %.3f %.3f\n", ok, p, t)
+	fmt.Printf("%.3f\n", vec.EstimateNormal(scene, p))
+
+	// Beside the sphere, the ray escapes.
+	ray.Origin = vec.Vec3{2, 0, 0}
+	_, _, ok = vec.Raymarch(scene, ray, 64)
+	fmt.Println(ok)
+
+	// Output:
+	// true (0.500, 0.000, -4.134) 4.134
+	// (0.500, 0.000, 0.866)
+	// false
+}
@@ -0,0 +1,24 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleSampleEdge() {
+	rect := vec.Rect{Min: vec.Vec2{0, 0}, Max: vec.Vec2{3, 1}}
+	fmt.Println("Perimeter:", rect.Perimeter())
+	fmt.Println("t=0.25:", vec.SampleEdge(rect, 0.25))
+	fmt.Println("t=1.5:", vec.SampleEdge(rect, 1.5))
+
+	// Evenly spaced points along a triangle.
+	tri := vec.Polygon{{0, 0}, {4, 0}, {0, 3}}
+	fmt.Println("Points:", vec.EdgePoints(tri, 4))
+
+	// Output:
+	// Perimeter: 8
+	// t=0.25: (2, 0)
+	// t=1.5: (3, 1)
+	// Points: [(0, 0) (3, 0) (2.4, 1.2000000000000002) (0, 3)]
+}
@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleSnap2() {
+	fmt.Println(vec.Snap2(vec.Vec2{13.7, 41}, 8))
+	fmt.Println(vec.Quantize2(vec.Vec2{13.7, 41}, vec.Vec2{0.5, 10}))
+	fmt.Println(vec.Snap3(vec.Vec3i{7, 12, 30}, 5))
+
+	// Output:
+	// (16, 40)
+	// (13.5, 40)
+	// (5, 10, 30)
+}
+
+func ExampleSnapToGrid() {
+	cell := vec.Vec2{32, 32}
+	origin := vec.Vec2{-16, 0}
+	c := vec.SnapToGrid(vec.Vec2{-20, 70}, cell, origin)
+	fmt.Println(c, vec.CellOrigin(c, cell, origin))
+
+	// Output:
+	// (-1, 2) (-48, 64)
+}
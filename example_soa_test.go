@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleVec2s() {
+	pos := vec.ToVec2s([]vec.Vec2{{0, 0}, {10, 5}, {-3, 4}})
+	vel := vec.ToVec2s([]vec.Vec2{{1, 0}, {0, 1}, {3, -4}})
+
+	// pos += vel * 0.5
+	step := vec.MakeVec2s[float64](pos.Len())
+	step.Muls(vel, 0.5)
+	pos.Add(pos, step)
+	fmt.Println(pos.Vecs())
+
+	dir := vec.MakeVec2s[float64](vel.Len())
+	dir.Normalize(vel)
+	dots := make([]float64, pos.Len())
+	vec.DotVec2s(dots, pos, dir)
+	fmt.Println(dir.At(2), dots)
+
+	// Output:
+	// [(0.5, 0) (10, 5.5) (-1.5, 2)]
+	// (0.6, -0.8) [0.5 5.5 -2.5]
+}
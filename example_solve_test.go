@@ -0,0 +1,55 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleSolve2x2() {
+	// x + 2y = 5, 3x + 4y = 6
+	x, ok := vec.Solve2x2(vec.Mat2{{1, 2}, {3, 4}}, vec.Vec2{5, 6})
+	fmt.Println(x, ok)
+	// Output:
+	// (-4, 4.5) true
+}
+
+func ExampleSolve3x3() {
+	m := vec.Mat3{{2, 1, 0}, {1, 3, 1}, {0, 1, 4}}
+	x, _ := vec.Solve3x3(m, vec.Vec3{1, 2, 3})
+	fmt.Printf("%.4f\n", x)
+	fmt.Println(m.MulVec(x))
+
+	// The rows are dependent, even if rounding leaves the determinant nonzero.
+	_, ok := vec.Solve3x3(vec.Mat3{{.1, .2, .3}, {.4, .5, .6}, {.7, .8, .9}}, vec.Vec3{1, 2, 3})
+	fmt.Println(ok)
+	// Output:
+	// (0.3333, 0.3333, 0.6667)
+	// (1, 2, 3)
+	// false
+}
+
+func ExampleLeastSquares2() {
+	// Fit y = a*x + b to noisy samples, one row (x, 1) per sample.
+	xs := []float64{0, 1, 2, 3}
+	ys := []float64{1, 3.1, 4.9, 7}
+	rows := make([]vec.Vec2, len(xs))
+	for i, x := range xs {
+		rows[i] = vec.Vec2{x, 1}
+	}
+	ab, _ := vec.LeastSquares2(rows, ys)
+	fmt.Printf("a=%.2f b=%.2f\n", ab.X, ab.Y)
+	// Output:
+	// a=1.98 b=1.03
+}
+
+func ExampleMat2_PseudoInverse() {
+	// The rows are parallel, so m has no inverse.
+	m := vec.Mat2{{1, 2}, {2, 4}}
+	_, ok := m.Inverse()
+	fmt.Println(ok)
+	fmt.Printf("%.2f\n", m.PseudoInverse().MulVec(vec.Vec2{1, 2}))
+	// Output:
+	// false
+	// (0.20, 0.40)
+}
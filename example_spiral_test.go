@@ -0,0 +1,40 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleSunflowerPoints() {
+	ps := vec.SunflowerPoints(100, 10)
+	fmt.Printf("%.2f %.2f\n", ps[0], ps[99])
+
+	// Every point lies within the disk.
+	var far float64
+	for _, p := range ps {
+		far = max(far, vec.Len2(p))
+	}
+	fmt.Printf("%.2f\n", far)
+
+	// Output:
+	// (0.71, 0.00) (3.94, -9.16)
+	// 9.97
+}
+
+func ExampleArchimedeanSpiral() {
+	// Points 1 apart along a spiral whose turns are 2 apart.
+	ps := vec.ArchimedeanSpiral(50, 2, 1)
+	fmt.Printf("%.2f %.2f\n", vec.Len2(ps[49]), vec.Distance(ps[48], ps[49]))
+
+	// Output:
+	// 5.65 1.00
+}
+
+func ExampleFibonacciSphere() {
+	dirs := vec.FibonacciSphere(4)
+	fmt.Printf("%.2f\n", dirs)
+
+	// Output:
+	// [(0.66, 0.00, 0.75) (-0.71, 0.65, 0.25) (0.08, -0.96, -0.25) (0.40, 0.52, -0.75)]
+}
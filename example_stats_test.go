@@ -0,0 +1,63 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func Example_statistics() {
+	points := []vec.Vec2{{1, 2}, {3, 2}, {3, 6}, {1, 6}}
+	fmt.Println("Sum:", vec.Sum2(points))
+	fmt.Println("Mean:", vec.Mean2(points))
+	fmt.Println("Variance:", vec.Variance2(points))
+	fmt.Println("StdDev:", vec.StdDev2(points))
+	fmt.Println("Covariance:", vec.Covariance2(points))
+	fmt.Println("Weighted mean:", vec.WeightedMean2(points, []float64{1, 1, 0, 0}))
+
+	// Integer vectors are accepted; means are always float64.
+	cells := []vec.Vec2i{{0, 0}, {1, 0}, {1, 1}}
+	fmt.Printf("Mean of cells: %.3f\n", vec.Mean2(cells).X)
+
+	// The area centroid of a polygon ignores extra boundary vertices.
+	poly := vec.Polygon{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 3}, {0, 3}}
+	fmt.Println("Vertex mean:", vec.Mean2(poly))
+	fmt.Println("Area centroid:", poly.Centroid())
+
+	// Output:
+	// Sum: (8, 16)
+	// Mean: (2, 4)
+	// Variance: (1, 4)
+	// StdDev: (1, 2)
+	// Covariance: [[1 0] [0 4]]
+	// Weighted mean: (2, 2)
+	// Mean of cells: 0.667
+	// Vertex mean: (1.5, 1)
+	// Area centroid: (1.5, 1.5)
+}
+
+func ExamplePCA2() {
+	// Points scattered along the diagonal y = x.
+	points := []vec.Vec2{{0, 0}, {1, 1.2}, {2, 1.8}, {3, 3.1}, {4, 3.9}}
+	axes, variances := vec.PCA2(points)
+	fmt.Printf("Major axis: (%.3f, %.3f), variance %.3f\n", axes[0].X, axes[0].Y, variances.X)
+	fmt.Printf("Minor axis: (%.3f, %.3f), variance %.3f\n", axes[1].X, axes[1].Y, variances.Y)
+
+	// Output:
+	// Major axis: (0.716, 0.698), variance 3.891
+	// Minor axis: (-0.698, 0.716), variance 0.009
+}
+
+func ExamplePCA3() {
+	// Points on the tilted plane z = x, spread mostly along y.
+	points := []vec.Vec3{{0, 0, 0}, {1, 0, 1}, {0, 4, 0}, {1, 4, 1}, {0, -4, 0}, {1, -4, 1}}
+	axes, variances := vec.PCA3(points)
+	for i, a := range axes {
+		fmt.Printf("Axis %d: (%.3f, %.3f, %.3f), variance %.3f\n", i, a.X, a.Y, a.Z, vec.ToArray3(variances)[i])
+	}
+
+	// Output:
+	// Axis 0: (0.000, 1.000, 0.000), variance 10.667
+	// Axis 1: (0.707, 0.000, 0.707), variance 0.500
+	// Axis 2: (0.707, 0.000, -0.707), variance 0.000
+}
@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleAppendStd140() {
+	// layout(std140) uniform Light {
+	//     vec3  dir;       // offset 0
+	//     float intensity; // offset 12
+	//     vec2  uv;        // offset 16
+	//     mat3  basis;     // offset 32, three 16-byte columns
+	//     float weights[2] // offset 80, 16-byte stride
+	// };
+	values := []any{vec.Vec3{0, -1, 0}, 0.8, vec.Vec2{0.5, 0.5}, vec.Identity3(), []float32{1, 2}}
+	fmt.Println(len(vec.AppendStd140(nil, values...)))
+	fmt.Println(len(vec.AppendStd430(nil, values...)))
+
+	fmt.Println(unsafe.Sizeof(vec.Vec3Std140{}), unsafe.Sizeof(vec.Mat3Std140{}))
+
+	// Output:
+	// 112
+	// 88
+	// 16 48
+}
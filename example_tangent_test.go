@@ -0,0 +1,70 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleTangentPoints() {
+	c := vec.Circle{Center: vec.Vec2{0, 0}, Radius: 3}
+	t1, t2, ok := vec.TangentPoints(vec.Vec2{5, 0}, c)
+	fmt.Printf("%v (%.1f, %.1f) (%.1f, %.1f)\n", ok, t1.X, t1.Y, t2.X, t2.Y)
+
+	// Output:
+	// true (1.8, 2.4) (1.8, -2.4)
+}
+
+func ExampleTangentPointsFromPoint() {
+	c := vec.Circle{Center: vec.Vec2{0, 0}, Radius: 3}
+	t1, t2, ok := vec.TangentPointsFromPoint(c, vec.Vec2{0, 5})
+	fmt.Printf("%v (%.1f, %.1f) (%.1f, %.1f)\n", ok, t1.X, t1.Y, t2.X, t2.Y)
+
+	// Output:
+	// true (-2.4, 1.8) (2.4, 1.8)
+}
+
+func ExampleExternalTangents() {
+	a := vec.Circle{Center: vec.Vec2{0, 0}, Radius: 1}
+	b := vec.Circle{Center: vec.Vec2{4, 0}, Radius: 1}
+
+	s1, s2, _ := vec.ExternalTangents(a, b)
+	fmt.Printf("External: (%.1f, %.1f)-(%.1f, %.1f) (%.1f, %.1f)-(%.1f, %.1f)\n",
+		s1.A.X, s1.A.Y, s1.B.X, s1.B.Y, s2.A.X, s2.A.Y, s2.B.X, s2.B.Y)
+
+	s1, _, _ = vec.InternalTangents(a, b)
+	fmt.Printf("Internal: (%.1f, %.1f)-(%.1f, %.1f)\n", s1.A.X, s1.A.Y, s1.B.X, s1.B.Y)
+
+	// Output:
+	// External: (0.0, 1.0)-(4.0, 1.0) (0.0, -1.0)-(4.0, -1.0)
+	// Internal: (0.5, 0.9)-(3.5, -0.9)
+}
+
+func ExampleTangentLinesBetweenCircles() {
+	a := vec.Circle{Center: vec.Vec2{0, 0}, Radius: 1}
+	b := vec.Circle{Center: vec.Vec2{2, 0}, Radius: 1}
+
+	// The circles touch, so the internal tangents coincide.
+	for _, s := range vec.TangentLinesBetweenCircles(a, b) {
+		fmt.Printf("(%.1f, %.1f)-(%.1f, %.1f)\n", s.A.X, s.A.Y, s.B.X, s.B.Y)
+	}
+
+	// Output:
+	// (0.0, 1.0)-(2.0, 1.0)
+	// (0.0, -1.0)-(2.0, -1.0)
+	// (1.0, 0.0)-(1.0, 0.0)
+}
+
+func ExampleArcPointAt() {
+	// A belt leaves a wheel of radius 2 at 90° and wraps clockwise to -90°.
+	sweep := vec.ArcSweep(math.Pi/2, -math.Pi/2, false)
+	fmt.Printf("%.1f %.2f\n", vec.Degrees(sweep), vec.ArcLength(2, sweep))
+
+	p := vec.ArcPointAt(vec.Vec2{0, 0}, 2, math.Pi/2, sweep, 0.5)
+	fmt.Printf("(%.1f, %.1f)\n", p.X, p.Y)
+
+	// Output:
+	// -180.0 6.28
+	// (2.0, 0.0)
+}
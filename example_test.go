@@ -32,19 +32,19 @@ func Example_arithmetic() {
 
 	// Output:
 	// Vector operations:
-	// a + b = {13 24}
-	// a - b = {7 16}
-	// a * b = {30 80}
-	// a / b = {3.3333333333333335 5}
+	// a + b = (13, 24)
+	// a - b = (7, 16)
+	// a * b = (30, 80)
+	// a / b = (3.3333333333333335, 5)
 	//
 	// Scalar operations:
-	// a + 2 = {12 22}
-	// a - 2 = {8 18}
-	// a * 2 = {20 40}
-	// a / 2 = {5 10}
+	// a + 2 = (12, 22)
+	// a - 2 = (8, 18)
+	// a * 2 = (20, 40)
+	// a / 2 = (5, 10)
 	//
 	// Other operations:
-	// -a = {-10 -20}
+	// -a = (-10, -20)
 	// a == b = false
 }
 
@@ -90,24 +90,24 @@ func Example_geometryAndTransformations() {
 
 	// Output:
 	// Geometric operations:
-	// Length of {3 4} = 5.00
+	// Length of (3, 4) = 5.00
 	// Squared length = 25
-	// Normalized = {0.6 0.8}
+	// Normalized = (0.6, 0.8)
 	// Angle = 0.93 radians
 	//
 	// Dot and cross products:
 	// Dot product = 3
 	// Cross product (2D) = -4
-	// Cross product (3D) = {-3 6 -3}
+	// Cross product (3D) = (-3, 6, -3)
 	//
 	// Projections and reflections:
-	// Project {1 -1} onto {0 1} = {-0 -1}
-	// Reflect {1 -1} off {0 1} = {1 1}
+	// Project (1, -1) onto (0, 1) = (-0, -1)
+	// Reflect (1, -1) off (0, 1) = (1, 1)
 	//
 	// Transformations:
-	// Lerp(a, b, 0.5) = {0.5 0.5}
-	// Rotate {1 0} by π/2 = {6.123233995736757e-17 1}
-	// Slerp(s1, s2, 0.5) = {0.7071067811865475 0.7071067811865475 0}
+	// Lerp(a, b, 0.5) = (0.5, 0.5)
+	// Rotate (1, 0) by π/2 = (6.123233995736757e-17, 1)
+	// Slerp(s1, s2, 0.5) = (0.7071067811865475, 0.7071067811865475, 0)
 }
 
 func Example_constructorsAndUtilities() {
@@ -156,19 +156,19 @@ func Example_constructorsAndUtilities() {
 
 	// Output:
 	// Constructors:
-	// New2(3, 4) = {3 4}
-	// NewAs2[int](3.4, 5.6) = {3 5}
-	// Splat2(5) = {5 5}
+	// New2(3, 4) = (3, 4)
+	// NewAs2[int](3.4, 5.6) = (3, 5)
+	// Splat2(5) = (5, 5)
 	//
 	// Type conversions:
-	// Original: {-3.7 4.2}
-	// To int: {-3 4}
-	// To float32: {-3.7 4.2}
-	// To uint8: {253 4}
+	// Original: (-3.7, 4.2)
+	// To int: (-3, 4)
+	// To float32: (-3.7, 4.2)
+	// To uint8: (253, 4)
 	//
 	// Dimension conversions:
-	// 2D to 3D: {1 2} -> {1 2 3}
-	// 3D to 2D: {3 4 5} -> {3 4}
+	// 2D to 3D: (1, 2) -> (1, 2, 3)
+	// 3D to 2D: (3, 4, 5) -> (3, 4)
 	//
 	// Array/slice conversions:
 	// To array: [1 2]
@@ -176,7 +176,7 @@ func Example_constructorsAndUtilities() {
 	// Components: x=1, y=2
 	//
 	// Functional operations:
-	// Map(Abs): {-1.5 2.7} -> {1.5 2.7}
-	// Zip(Max): {10 20}, {3 7} -> {10 20}
-	// Apply(RGBToYCbCr): {255 128 64} -> {159 75 197}
+	// Map(Abs): (-1.5, 2.7) -> (1.5, 2.7)
+	// Zip(Max): (10, 20), (3, 7) -> (10, 20)
+	// Apply(RGBToYCbCr): (255, 128, 64) -> (159, 75, 197)
 }
@@ -0,0 +1,49 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleTransform2() {
+	body := vec.NewTransform2()
+	body.SetPosition(vec.Vec2{10, 0})
+
+	arm := vec.NewTransform2()
+	arm.SetParent(body)
+	arm.SetPosition(vec.Vec2{0, 2})
+	arm.SetRotation(math.Pi / 2)
+
+	fmt.Printf("%.0f\n", arm.WorldPosition())
+	fmt.Printf("%.0f\n", arm.LocalToWorld(vec.Vec2{1, 0}))
+
+	// Moving the parent moves the child.
+	body.SetPosition(vec.Vec2{20, 0})
+	fmt.Printf("%.0f\n", arm.WorldPosition())
+	fmt.Printf("%.0f\n", arm.WorldToLocal(vec.Vec2{20, 5}))
+
+	// Output:
+	// (10, 2)
+	// (10, 3)
+	// (20, 2)
+	// (3, 0)
+}
+
+func ExampleTransform3() {
+	root := vec.NewTransform3()
+	root.SetRotation(vec.QuatFromAxisAngle(vec.Vec3{0, 1, 0}, math.Pi/2))
+	root.SetScale(vec.Vec3{2, 2, 2})
+
+	child := vec.NewTransform3()
+	child.SetParent(root)
+	child.SetPosition(vec.Vec3{1, 0, 0})
+
+	fmt.Printf("%.3f\n", child.WorldPosition())
+	fmt.Printf("%.3f\n", child.WorldToLocal(vec.Vec3{-2, 2, -4}))
+
+	// Output:
+	// (0.000, 0.000, -2.000)
+	// (1.000, 1.000, -1.000)
+}
@@ -0,0 +1,51 @@
+package vec_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleTrilaterate2() {
+	beacons := []vec.Vec2{{0, 0}, {10, 0}, {0, 10}}
+	ranges := []float64{5, math.Sqrt(65), math.Sqrt(45)}
+	p, ok := vec.Trilaterate2(beacons, ranges)
+	fmt.Printf("%.2f %v\n", p, ok)
+
+	// Beacons on one line cannot tell which side of it the listener is on.
+	line := []vec.Vec2{{0.1, 0.3}, {0.2, 0.6}, {0.3, 0.9}, {0.7, 2.1}}
+	_, ok = vec.Trilaterate2(line, []float64{1, 2, 3, 4})
+	fmt.Println(ok)
+	// Output:
+	// (3.00, 4.00) true
+	// false
+}
+
+func ExampleTrilaterate3() {
+	anchors := []vec.Vec3{{0, 0, 0}, {5, 0, 0}, {0, 5, 0}, {0, 0, 5}}
+	target := vec.Vec3{1, 2, 3}
+	ranges := make([]float64, len(anchors))
+	for i, a := range anchors {
+		ranges[i] = vec.Distance(a, target)
+	}
+	p, _ := vec.Trilaterate3(anchors, ranges)
+	fmt.Printf("%.2f\n", p)
+	// Output:
+	// (1.00, 2.00, 3.00)
+}
+
+func ExampleTriangulate2() {
+	// Two listeners hear a sound to the upper right and upper left.
+	listeners := []vec.Vec2{{0, 0}, {10, 0}}
+	bearings := []float64{math.Pi / 4, 3 * math.Pi / 4}
+	p, ok := vec.Triangulate2(listeners, bearings)
+	fmt.Printf("%.2f %v\n", p, ok)
+
+	// Parallel lines of sight never meet.
+	_, ok = vec.Triangulate2(listeners, []float64{0.3, 0.3})
+	fmt.Println(ok)
+	// Output:
+	// (5.00, 5.00) true
+	// false
+}
@@ -0,0 +1,38 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleVecN() {
+	a := vec.NewN(1.0, 2, 3, 4, 5)
+	b := vec.SplatN(5, 1.0)
+
+	fmt.Println(a.Add(b))
+	fmt.Println(vec.DotN(a, b))
+	fmt.Printf("%.2f\n", vec.NormalizeN(b))
+	fmt.Println(vec.LerpN(a, b, 0.5))
+	fmt.Printf("%+v\n", vec.NewN(3, 4))
+
+	// Output:
+	// (2, 3, 4, 5, 6)
+	// 15
+	// (0.45, 0.45, 0.45, 0.45, 0.45)
+	// (1, 1.5, 2, 2.5, 3)
+	// (0: 3, 1: 4)
+}
+
+func ExampleVecN_Format() {
+	v := vec.VecN[float64]{1, 2.5, -3}
+	fmt.Printf("%v\n", v)
+	fmt.Printf("%.2f\n", v)
+	fmt.Printf("%+v\n", v)
+	fmt.Printf("%#v\n", v)
+	// Output:
+	// (1, 2.5, -3)
+	// (1.00, 2.50, -3.00)
+	// (0: 1, 1: 2.5, 2: -3)
+	// vec.VecN[float64]{1, 2.5, -3}
+}
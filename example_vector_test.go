@@ -0,0 +1,31 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+// centroid is written once for every vector dimension.
+func centroid[V vec.Vector[V, S], S vec.Scalar](vs ...V) V {
+	var sum V
+	for _, v := range vs {
+		sum = sum.Add(v)
+	}
+	return sum.Divs(S(len(vs)))
+}
+
+func ExampleVector() {
+	fmt.Println(centroid(vec.Vec2{0, 0}, vec.Vec2{4, 0}, vec.Vec2{2, 6}))
+	fmt.Println(centroid(vec.Vec3i{1, 2, 3}, vec.Vec3i{3, 4, 5}))
+
+	a, b := vec.Vec3{0, 0, 0}, vec.Vec3{2, 4, 4}
+	fmt.Println(vec.Lerp(a, b, 0.5), vec.Distance(a, b))
+	fmt.Println(vec.Clamp(vec.Vec2i{-5, 7}, vec.Vec2i{0, 0}, vec.Vec2i{3, 3}))
+
+	// Output:
+	// (2, 2)
+	// (2, 3, 4)
+	// (1, 2, 2) 6
+	// (0, 3)
+}
@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleParticleSystem() {
+	// A rope of four links, held at the origin and laid out sideways.
+	rope := vec.ParticleSystem[vec.Vec2]{Gravity: vec.Vec2{0, -10}, Damping: 0.02}
+	for i := range 5 {
+		rope.AddParticle(vec.Vec2{float64(i), 0})
+		if i > 0 {
+			rope.Connect(i-1, i)
+		}
+	}
+	rope.Pin(0, vec.Vec2{0, 0})
+
+	// Let it swing down and settle.
+	for range 2000 {
+		rope.Step(1.0/60, 20)
+	}
+	fmt.Printf("%.2f\n", rope.Pos[4])
+
+	// Output:
+	// (0.00, -4.00)
+}
@@ -0,0 +1,30 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleVertexLayout_Interleave() {
+	pos := []vec.Vec3{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}
+	uv := []vec.Vec2{{0, 0}, {1, 0}, {0, 1}}
+	color := []vec.Vec4{{1, 0, 0, 1}, {0, 1, 0, 1}, {0, 0, 1, 1}}
+
+	layout := vec.PackedLayout(vec.Float32x3, vec.Float32x2, vec.Unorm8x4)
+	buf, err := layout.Interleave(pos, uv, color)
+	fmt.Println(layout.Stride, len(buf), err)
+	fmt.Printf("% x\n", buf[20:24])
+
+	fs, _ := vec.PackedLayout(vec.Float32x3, vec.Float32x2).InterleaveFloat32(pos, uv)
+	fmt.Println(fs[5:10])
+
+	_, err = layout.Interleave(pos, uv, color[:2])
+	fmt.Println(err)
+
+	// Output:
+	// 24 72 <nil>
+	// ff 00 00 ff
+	// [1 0 0 1 0]
+	// vec: attribute 2 has 2 vertices, want 3
+}
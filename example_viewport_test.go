@@ -0,0 +1,25 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExamplePixelToNDC() {
+	// An 800x600 window, with Y down on screen and up in NDC.
+	viewport := vec.Rect{Max: vec.Vec2{800, 600}}
+	mouse := vec.Vec2{600, 150}
+
+	ndc := vec.PixelToNDC(mouse, viewport, true)
+	fmt.Println(ndc, vec.NDCToPixel(ndc, viewport, true))
+
+	// Texture lookups with V up, as in OpenGL, and with V down.
+	fmt.Println(vec.PixelToUV(mouse, viewport, true), vec.PixelToUV(mouse, viewport, false))
+	fmt.Println(vec.NDCToUV(ndc, false), vec.UVToNDC(vec.Vec2{0.5, 0.5}, true))
+
+	// Output:
+	// (0.5, 0.5) (600, 150)
+	// (0.75, 0.75) (0.75, 0.25)
+	// (0.75, 0.75) (0, 0)
+}
@@ -0,0 +1,29 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleVoronoiCells() {
+	bounds := vec.Rect{Max: vec.Vec2{4, 2}}
+	sites := []vec.Vec2{{1, 1}, {3, 1}}
+	for _, cell := range vec.VoronoiCells(sites, bounds) {
+		fmt.Println(cell, cell.Area())
+	}
+
+	// Output:
+	// [(0, 0) (2, 0) (2, 2) (0, 2)] 4
+	// [(2, 0) (4, 0) (4, 2) (2, 2)] 4
+}
+
+func ExampleLloydRelax() {
+	// Three sites crowded into one corner spread out over the square.
+	bounds := vec.Rect{Max: vec.Vec2{3, 3}}
+	sites := []vec.Vec2{{0.1, 0.1}, {0.2, 0.1}, {0.1, 0.3}}
+	fmt.Printf("%.2f\n", vec.LloydRelax(sites, bounds, 50))
+
+	// Output:
+	// [(0.69, 0.95) (2.31, 0.95) (1.50, 2.41)]
+}
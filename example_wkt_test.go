@@ -0,0 +1,28 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleFormatWKTPolygon() {
+	outer := vec.Polygon{{0, 0}, {4, 0}, {4, 4}, {0, 4}}
+	hole := vec.Polygon{{1, 1}, {1, 2}, {2, 2}}
+	fmt.Println(vec.FormatWKTPoint(vec.Vec2{1.5, -2}))
+	fmt.Println(vec.FormatWKTPolygon([]vec.Polygon{outer, hole}))
+	// Output:
+	// POINT (1.5 -2)
+	// POLYGON ((0 0, 4 0, 4 4, 0 4, 0 0), (1 1, 1 2, 2 2, 1 1))
+}
+
+func ExampleParseWKTLineString() {
+	path, err := vec.ParseWKTLineString("LineString (30 10, 10 30, 40 40)")
+	fmt.Println(path, err)
+
+	_, err = vec.ParseWKTPoint("POINT (1 2 3)")
+	fmt.Println(err)
+	// Output:
+	// [(30, 10) (10, 30) (40, 40)] <nil>
+	// vec: parsing WKT "POINT (1 2 3)": expected 2 coordinates, got 3
+}
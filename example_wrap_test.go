@@ -0,0 +1,29 @@
+package vec_test
+
+import (
+	"fmt"
+
+	"github.com/eihigh/vec"
+)
+
+func ExampleWrap2() {
+	world := vec.Vec2{800, 600}
+	fmt.Println(vec.Wrap2(vec.Vec2{810, -25}, vec.Vec2{}, world))
+	fmt.Println(vec.Wrap2(vec.Vec2i{-1, 17}, vec.Vec2i{0, 0}, vec.Vec2i{16, 16}))
+
+	// Output:
+	// (10, 575)
+	// (15, 1)
+}
+
+func ExampleMirrorRepeat2() {
+	fmt.Println(vec.MirrorRepeat2(vec.Vec2{1.25, -0.25}, vec.Vec2{0, 0}, vec.Vec2{1, 1}))
+	for x := range 8 {
+		fmt.Print(vec.MirrorRepeat2(vec.Vec2i{x, 0}, vec.Vec2i{0, 0}, vec.Vec2i{3, 3}).X)
+	}
+	fmt.Println()
+
+	// Output:
+	// (0.75, 0.25)
+	// 01221001
+}
@@ -0,0 +1,44 @@
+package vec
+
+import "math"
+
+// FalloffLinear returns a weight that decreases linearly from 1 at dist 0
+// to 0 at dist >= radius.
+func FalloffLinear(dist, radius float64) float64 {
+	if radius <= 0 || dist >= radius {
+		return 0
+	}
+	return 1 - max(dist, 0)/radius
+}
+
+// FalloffSmooth returns a weight that decreases from 1 at dist 0 to 0 at
+// dist >= radius along a smoothstep curve, with zero slope at both ends.
+func FalloffSmooth(dist, radius float64) float64 {
+	t := FalloffLinear(dist, radius)
+	return t * t * (3 - 2*t)
+}
+
+// FalloffGauss returns a Gaussian weight of 1 at dist 0 with a standard
+// deviation of radius/3, cut off to 0 at dist >= radius.
+func FalloffGauss(dist, radius float64) float64 {
+	if radius <= 0 || dist >= radius {
+		return 0
+	}
+	x := 3 * dist / radius
+	return math.Exp(-x * x / 2)
+}
+
+// ApplyRadialForce pushes each of points within radius of center away from
+// it by strength scaled by FalloffSmooth, modifying points in place.
+// A negative strength pulls points toward the center instead.
+// Points exactly at center are left unchanged.
+func ApplyRadialForce(points []Vec2, center Vec2, radius, strength float64) {
+	for i, p := range points {
+		d := p.Sub(center)
+		dist := Len2(d)
+		if dist == 0 || dist >= radius {
+			continue
+		}
+		points[i] = p.Add(d.Scale(strength * FalloffSmooth(dist, radius) / dist))
+	}
+}
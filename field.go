@@ -0,0 +1,63 @@
+package vec
+
+// The functions below estimate derivatives of fields by central
+// differences, sampling the field eps away from p along each axis. The
+// error shrinks with eps squared until rounding takes over, so eps around
+// 1e-4 of the scale of the field's features is a good start.
+
+// Gradient2 returns the gradient of the scalar field f at p, pointing in
+// the direction of steepest increase, such as uphill or away from the
+// surface of a signed distance field.
+func Gradient2(f func(Vec2) float64, p Vec2, eps float64) Vec2 {
+	dx, dy := Vec2{eps, 0}, Vec2{0, eps}
+	return Vec2{f(p.Add(dx)) - f(p.Sub(dx)), f(p.Add(dy)) - f(p.Sub(dy))}.Scale(1 / (2 * eps))
+}
+
+// Gradient3 returns the gradient of the scalar field f at p, pointing in
+// the direction of steepest increase.
+func Gradient3(f func(Vec3) float64, p Vec3, eps float64) Vec3 {
+	dx, dy, dz := Vec3{eps, 0, 0}, Vec3{0, eps, 0}, Vec3{0, 0, eps}
+	return Vec3{
+		f(p.Add(dx)) - f(p.Sub(dx)),
+		f(p.Add(dy)) - f(p.Sub(dy)),
+		f(p.Add(dz)) - f(p.Sub(dz)),
+	}.Scale(1 / (2 * eps))
+}
+
+// Divergence2 returns the divergence of the vector field f at p: positive
+// where the flow spreads out from p and negative where it converges.
+func Divergence2(f func(Vec2) Vec2, p Vec2, eps float64) float64 {
+	dx, dy := Vec2{eps, 0}, Vec2{0, eps}
+	return (f(p.Add(dx)).X - f(p.Sub(dx)).X + f(p.Add(dy)).Y - f(p.Sub(dy)).Y) / (2 * eps)
+}
+
+// Divergence3 returns the divergence of the vector field f at p.
+func Divergence3(f func(Vec3) Vec3, p Vec3, eps float64) float64 {
+	dx, dy, dz := Vec3{eps, 0, 0}, Vec3{0, eps, 0}, Vec3{0, 0, eps}
+	return (f(p.Add(dx)).X - f(p.Sub(dx)).X +
+		f(p.Add(dy)).Y - f(p.Sub(dy)).Y +
+		f(p.Add(dz)).Z - f(p.Sub(dz)).Z) / (2 * eps)
+}
+
+// Curl2 returns the curl of the vector field f at p, the rate at which the
+// flow turns counter-clockwise around p.
+func Curl2(f func(Vec2) Vec2, p Vec2, eps float64) float64 {
+	dx, dy := Vec2{eps, 0}, Vec2{0, eps}
+	return (f(p.Add(dx)).Y - f(p.Sub(dx)).Y - f(p.Add(dy)).X + f(p.Sub(dy)).X) / (2 * eps)
+}
+
+// Laplacian2 returns the Laplacian of the scalar field f at p, the
+// divergence of its gradient: positive where p lies below the average of
+// its surroundings.
+func Laplacian2(f func(Vec2) float64, p Vec2, eps float64) float64 {
+	dx, dy := Vec2{eps, 0}, Vec2{0, eps}
+	return (f(p.Add(dx)) + f(p.Sub(dx)) + f(p.Add(dy)) + f(p.Sub(dy)) - 4*f(p)) / (eps * eps)
+}
+
+// Laplacian3 returns the Laplacian of the scalar field f at p.
+func Laplacian3(f func(Vec3) float64, p Vec3, eps float64) float64 {
+	dx, dy, dz := Vec3{eps, 0, 0}, Vec3{0, eps, 0}, Vec3{0, 0, eps}
+	return (f(p.Add(dx)) + f(p.Sub(dx)) +
+		f(p.Add(dy)) + f(p.Sub(dy)) +
+		f(p.Add(dz)) + f(p.Sub(dz)) - 6*f(p)) / (eps * eps)
+}
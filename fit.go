@@ -0,0 +1,79 @@
+package vec
+
+import "math"
+
+// FitLine2 returns the line minimizing the squared perpendicular distances
+// to points, along with the root-mean-square distance of the points to it.
+// The line's Dir is a unit vector.
+// Returns false if there are fewer than two distinct points.
+func FitLine2(points []Vec2) (l Line2, rms float64, ok bool) {
+	axes, variances := PCA2(points)
+	if len(points) < 2 || variances.X == 0 {
+		return Line2{}, 0, false
+	}
+	return Line2{Mean2(points), axes[0]}, math.Sqrt(max(variances.Y, 0)), true
+}
+
+// collinearTolerance is the ratio of the variance across the principal
+// axis to the variance along it below which points count as collinear.
+const collinearTolerance = 1e-12
+
+// FitPlane3 returns the plane minimizing the squared perpendicular distances
+// to points, along with the root-mean-square distance of the points to it.
+// Returns false if the points are all collinear, or so nearly that the
+// normal would be decided by rounding.
+func FitPlane3(points []Vec3) (pl Plane, rms float64, ok bool) {
+	axes, variances := PCA3(points)
+	if len(points) < 3 || variances.Y <= collinearTolerance*variances.X {
+		return Plane{}, 0, false
+	}
+	n := axes[2]
+	return Plane{n, Dot3(n, Mean3(points))}, math.Sqrt(max(variances.Z, 0)), true
+}
+
+// FitCircle2 returns the circle best fitting points in the algebraic
+// least-squares sense (Kåsa fit), along with the root-mean-square distance
+// of the points to the circle.
+// Returns false if there are fewer than three points or they are collinear,
+// or nearly so, as for FitPlane3.
+func FitCircle2(points []Vec2) (c Circle, rms float64, ok bool) {
+	if len(points) < 3 {
+		return Circle{}, 0, false
+	}
+	if _, variances := PCA2(points); variances.Y <= collinearTolerance*variances.X {
+		return Circle{}, 0, false
+	}
+	// Work relative to the mean for numerical stability, and solve
+	// x²+y² + D*x + E*y + F = 0 by the normal equations.
+	mean := Mean2(points)
+	var m Mat3
+	var rhs Vec3
+	for _, p := range points {
+		d := p.Sub(mean)
+		row := [3]float64{d.X, d.Y, 1}
+		z := -LenSq2(d)
+		for i := range 3 {
+			for j := range 3 {
+				m[i][j] += row[i] * row[j]
+			}
+		}
+		rhs = rhs.Add(Vec3{row[0], row[1], row[2]}.Scale(z))
+	}
+	sol, ok := Solve3x3(m, rhs)
+	if !ok {
+		return Circle{}, 0, false
+	}
+	center := Vec2{-sol.X / 2, -sol.Y / 2}
+	r2 := LenSq2(center) - sol.Z
+	if r2 <= 0 || math.IsInf(r2, 0) || math.IsNaN(r2) {
+		return Circle{}, 0, false
+	}
+	c = Circle{center.Add(mean), math.Sqrt(r2)}
+
+	var sum float64
+	for _, p := range points {
+		d := Len2(p.Sub(c.Center)) - c.Radius
+		sum += d * d
+	}
+	return c, math.Sqrt(sum / float64(len(points))), true
+}
@@ -0,0 +1,113 @@
+package vec
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// A VecNg[S] has the memory layout of [N]S, so a []Vec3 can be viewed as
+// a []float64 of three times the length and back without copying. The
+// As functions share memory with their argument, for handing buffers to
+// GPU APIs, cgo or gonum; Flatten and Unflatten copy instead.
+
+// AsScalars2 returns the components of vs as a flat slice sharing its memory.
+func AsScalars2[S Scalar](vs []Vec2g[S]) []S {
+	return unsafe.Slice((*S)(unsafe.Pointer(unsafe.SliceData(vs))), 2*len(vs))
+}
+
+// AsVecs2 returns s as a slice of vectors sharing its memory.
+// Returns an error if len(s) is not a multiple of 2 or s is misaligned.
+func AsVecs2[S Scalar](s []S) ([]Vec2g[S], error) {
+	if err := checkFlat(s, 2); err != nil {
+		return nil, err
+	}
+	return unsafe.Slice((*Vec2g[S])(unsafe.Pointer(unsafe.SliceData(s))), len(s)/2), nil
+}
+
+// Flatten2 returns the components of vs as a new flat slice.
+func Flatten2[S Scalar](vs []Vec2g[S]) []S {
+	return append([]S(nil), AsScalars2(vs)...)
+}
+
+// Unflatten2 returns a new slice of the vectors whose components are s.
+// Returns an error if len(s) is not a multiple of 2.
+func Unflatten2[S Scalar](s []S) ([]Vec2g[S], error) {
+	if len(s)%2 != 0 {
+		return nil, fmt.Errorf("vec: flat slice length %d is not a multiple of 2", len(s))
+	}
+	vs := make([]Vec2g[S], len(s)/2)
+	copy(AsScalars2(vs), s)
+	return vs, nil
+}
+
+// AsScalars3 returns the components of vs as a flat slice sharing its memory.
+func AsScalars3[S Scalar](vs []Vec3g[S]) []S {
+	return unsafe.Slice((*S)(unsafe.Pointer(unsafe.SliceData(vs))), 3*len(vs))
+}
+
+// AsVecs3 returns s as a slice of vectors sharing its memory.
+// Returns an error if len(s) is not a multiple of 3 or s is misaligned.
+func AsVecs3[S Scalar](s []S) ([]Vec3g[S], error) {
+	if err := checkFlat(s, 3); err != nil {
+		return nil, err
+	}
+	return unsafe.Slice((*Vec3g[S])(unsafe.Pointer(unsafe.SliceData(s))), len(s)/3), nil
+}
+
+// Flatten3 returns the components of vs as a new flat slice.
+func Flatten3[S Scalar](vs []Vec3g[S]) []S {
+	return append([]S(nil), AsScalars3(vs)...)
+}
+
+// Unflatten3 returns a new slice of the vectors whose components are s.
+// Returns an error if len(s) is not a multiple of 3.
+func Unflatten3[S Scalar](s []S) ([]Vec3g[S], error) {
+	if len(s)%3 != 0 {
+		return nil, fmt.Errorf("vec: flat slice length %d is not a multiple of 3", len(s))
+	}
+	vs := make([]Vec3g[S], len(s)/3)
+	copy(AsScalars3(vs), s)
+	return vs, nil
+}
+
+// AsScalars4 returns the components of vs as a flat slice sharing its memory.
+func AsScalars4[S Scalar](vs []Vec4g[S]) []S {
+	return unsafe.Slice((*S)(unsafe.Pointer(unsafe.SliceData(vs))), 4*len(vs))
+}
+
+// AsVecs4 returns s as a slice of vectors sharing its memory.
+// Returns an error if len(s) is not a multiple of 4 or s is misaligned.
+func AsVecs4[S Scalar](s []S) ([]Vec4g[S], error) {
+	if err := checkFlat(s, 4); err != nil {
+		return nil, err
+	}
+	return unsafe.Slice((*Vec4g[S])(unsafe.Pointer(unsafe.SliceData(s))), len(s)/4), nil
+}
+
+// Flatten4 returns the components of vs as a new flat slice.
+func Flatten4[S Scalar](vs []Vec4g[S]) []S {
+	return append([]S(nil), AsScalars4(vs)...)
+}
+
+// Unflatten4 returns a new slice of the vectors whose components are s.
+// Returns an error if len(s) is not a multiple of 4.
+func Unflatten4[S Scalar](s []S) ([]Vec4g[S], error) {
+	if len(s)%4 != 0 {
+		return nil, fmt.Errorf("vec: flat slice length %d is not a multiple of 4", len(s))
+	}
+	vs := make([]Vec4g[S], len(s)/4)
+	copy(AsScalars4(vs), s)
+	return vs, nil
+}
+
+// checkFlat reports whether s can be viewed as a slice of n-component vectors.
+func checkFlat[S Scalar](s []S, n int) error {
+	if len(s)%n != 0 {
+		return fmt.Errorf("vec: flat slice length %d is not a multiple of %d", len(s), n)
+	}
+	var z S
+	if uintptr(unsafe.Pointer(unsafe.SliceData(s)))%unsafe.Alignof(z) != 0 {
+		return fmt.Errorf("vec: flat slice is not aligned for its scalar type")
+	}
+	return nil
+}
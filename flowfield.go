@@ -0,0 +1,102 @@
+package vec
+
+import (
+	"container/heap"
+	"math"
+)
+
+// DijkstraMap returns the travel distance from every cell of a grid of the
+// given size to the nearest goal, moving between passable cells in 8
+// directions. Edge steps cost 1 and diagonal steps cost √2; diagonal steps
+// may not cut the corner of an impassable cell. Unreachable and impassable
+// cells are set to +Inf. Goals outside the grid or not passable are ignored.
+func DijkstraMap(size Vec2i, goal []Vec2i, passable func(Vec2i) bool) *Grid[float64] {
+	dist := NewGrid[float64](size)
+	dist.Fill(math.Inf(1))
+	var q flowQueue
+	for _, p := range goal {
+		if dist.In(p) && passable(p) && dist.At(p) != 0 {
+			dist.Set(p, 0)
+			heap.Push(&q, flowItem{p, 0})
+		}
+	}
+	for q.Len() > 0 {
+		it := heap.Pop(&q).(flowItem)
+		if it.dist > dist.At(it.p) {
+			continue // stale entry
+		}
+		for i, d := range gridDirs8 {
+			n := it.p.Add(d)
+			if !flowStep(dist, passable, it.p, d) {
+				continue
+			}
+			nd := it.dist + 1
+			if i%2 == 1 {
+				nd = it.dist + math.Sqrt2
+			}
+			if nd < dist.At(n) {
+				dist.Set(n, nd)
+				heap.Push(&q, flowItem{n, nd})
+			}
+		}
+	}
+	return dist
+}
+
+// GenerateFlowField returns a grid of the given size in which each cell
+// holds the unit direction to step toward the nearest goal, following
+// DijkstraMap. Goals, unreachable cells and impassable cells hold the zero
+// vector. Many agents can share the field and look up their next move in
+// constant time.
+func GenerateFlowField(size Vec2i, goal []Vec2i, passable func(Vec2i) bool) *Grid[Vec2] {
+	dist := DijkstraMap(size, goal, passable)
+	field := NewGrid[Vec2](size)
+	for p, dp := range dist.All() {
+		if dp == 0 || math.IsInf(dp, 1) {
+			continue
+		}
+		best, bestDir := dp, Vec2i{}
+		for _, d := range gridDirs8 {
+			if !flowStep(dist, passable, p, d) {
+				continue
+			}
+			if dn := dist.At(p.Add(d)); dn < best {
+				best, bestDir = dn, d
+			}
+		}
+		field.Set(p, Normalize2(As2[float64](bestDir)))
+	}
+	return field
+}
+
+// flowStep reports whether a move from p in direction d stays on passable
+// cells of g without cutting a corner.
+func flowStep[T any](g *Grid[T], passable func(Vec2i) bool, p, d Vec2i) bool {
+	n := p.Add(d)
+	if !g.In(n) || !passable(n) {
+		return false
+	}
+	if d.X != 0 && d.Y != 0 {
+		return passable(Vec2i{n.X, p.Y}) && passable(Vec2i{p.X, n.Y})
+	}
+	return true
+}
+
+type flowItem struct {
+	p    Vec2i
+	dist float64
+}
+
+// flowQueue is a min-heap of cells ordered by distance.
+type flowQueue []flowItem
+
+func (q flowQueue) Len() int           { return len(q) }
+func (q flowQueue) Less(i, j int) bool { return q[i].dist < q[j].dist }
+func (q flowQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *flowQueue) Push(x any)        { *q = append(*q, x.(flowItem)) }
+func (q *flowQueue) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
@@ -0,0 +1,108 @@
+package vec
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// String returns a formatted as "(x, y)".
+func (a Vec2g[S]) String() string { return fmt.Sprint(a) }
+
+// String returns a formatted as "(x, y, z)".
+func (a Vec3g[S]) String() string { return fmt.Sprint(a) }
+
+// String returns a formatted as "(x, y, z, w)".
+func (a Vec4g[S]) String() string { return fmt.Sprint(a) }
+
+// AppendText implements encoding.TextAppender, appending a as "x,y".
+// The compact form suits map keys and YAML or TOML scalars, and is
+// accepted by UnmarshalText.
+func (a Vec2g[S]) AppendText(b []byte) ([]byte, error) {
+	return appendComponents(b, a.X, a.Y), nil
+}
+
+// AppendText implements encoding.TextAppender, appending a as "x,y,z".
+func (a Vec3g[S]) AppendText(b []byte) ([]byte, error) {
+	return appendComponents(b, a.X, a.Y, a.Z), nil
+}
+
+// AppendText implements encoding.TextAppender, appending a as "x,y,z,w".
+func (a Vec4g[S]) AppendText(b []byte) ([]byte, error) {
+	return appendComponents(b, a.X, a.Y, a.Z, a.W), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. See AppendText.
+func (a Vec2g[S]) MarshalText() ([]byte, error) { return a.AppendText(nil) }
+
+// MarshalText implements encoding.TextMarshaler. See AppendText.
+func (a Vec3g[S]) MarshalText() ([]byte, error) { return a.AppendText(nil) }
+
+// MarshalText implements encoding.TextMarshaler. See AppendText.
+func (a Vec4g[S]) MarshalText() ([]byte, error) { return a.AppendText(nil) }
+
+func appendComponents[S Scalar](b []byte, cs ...S) []byte {
+	for i, c := range cs {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = fmt.Append(b, c)
+	}
+	return b
+}
+
+// Format implements fmt.Formatter. See formatVec for the supported verbs.
+func (a Vec2g[S]) Format(f fmt.State, verb rune) {
+	formatVec(f, verb, a, []S{a.X, a.Y})
+}
+
+// Format implements fmt.Formatter. See formatVec for the supported verbs.
+func (a Vec3g[S]) Format(f fmt.State, verb rune) {
+	formatVec(f, verb, a, []S{a.X, a.Y, a.Z})
+}
+
+// Format implements fmt.Formatter. See formatVec for the supported verbs.
+func (a Vec4g[S]) Format(f fmt.State, verb rune) {
+	formatVec(f, verb, a, []S{a.X, a.Y, a.Z, a.W})
+}
+
+// formatVec writes the components cs of the vector v as "(x, y, ...)".
+// Numeric verbs, flags, width and precision apply to each component, so
+// "%.2f" gives "(3.00, 4.00)". %v and %s use the default component format,
+// %+v adds component names as in "(x: 3, y: 4)", %#v prints Go syntax,
+// and %q quotes the %v form.
+func formatVec[S Scalar](f fmt.State, verb rune, v any, cs []S) {
+	const names = "xyzw"
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%T{", v)
+		for i, c := range cs {
+			if i > 0 {
+				fmt.Fprint(f, ", ")
+			}
+			fmt.Fprintf(f, "%c:%#v", names[i]-'a'+'A', c)
+		}
+		fmt.Fprint(f, "}")
+		return
+	case verb == 'q':
+		fmt.Fprint(f, strconv.Quote(fmt.Sprint(v)))
+		return
+	}
+
+	directive := fmt.FormatString(f, verb)
+	if verb == 's' {
+		directive = fmt.FormatString(f, 'v')
+	}
+	named := verb == 'v' && f.Flag('+')
+	fmt.Fprint(f, "(")
+	for i, c := range cs {
+		if i > 0 {
+			fmt.Fprint(f, ", ")
+		}
+		if named {
+			fmt.Fprintf(f, "%c: %v", names[i], c)
+			continue
+		}
+		fmt.Fprintf(f, directive, c)
+	}
+	fmt.Fprint(f, ")")
+}
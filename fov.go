@@ -0,0 +1,93 @@
+package vec
+
+// FieldOfView returns the cells visible from origin within radius,
+// including origin, using symmetric shadowcasting: a cell is visible when
+// some line from the center of origin reaches its center unblocked, and
+// visibility is symmetric between floor cells. Opaque cells that bound
+// the view are themselves visible. opaque reports whether a cell blocks
+// sight; it is called only for cells within the radius.
+func FieldOfView(origin Vec2i, radius int, opaque func(Vec2i) bool) []Vec2i {
+	f := fov{origin: origin, radius: radius, opaque: opaque, seen: map[Vec2i]bool{}}
+	f.reveal(origin)
+	if radius < 1 {
+		return f.cells
+	}
+	for q := range 4 {
+		f.quadrant = q
+		f.scan(1, slope{-1, 1}, slope{1, 1})
+	}
+	return f.cells
+}
+
+// slope is the rational slope num/den, with den > 0.
+type slope struct{ num, den int }
+
+type fov struct {
+	origin   Vec2i
+	radius   int
+	opaque   func(Vec2i) bool
+	quadrant int
+	seen     map[Vec2i]bool
+	cells    []Vec2i
+}
+
+// cell returns the cell at depth rows from the origin and col columns
+// across, in the current quadrant.
+func (f *fov) cell(depth, col int) Vec2i {
+	switch f.quadrant {
+	case 0:
+		return Vec2i{f.origin.X + col, f.origin.Y - depth}
+	case 1:
+		return Vec2i{f.origin.X + depth, f.origin.Y + col}
+	case 2:
+		return Vec2i{f.origin.X + col, f.origin.Y + depth}
+	default:
+		return Vec2i{f.origin.X - depth, f.origin.Y + col}
+	}
+}
+
+func (f *fov) inRadius(p Vec2i) bool {
+	d := p.Sub(f.origin)
+	return d.X*d.X+d.Y*d.Y <= f.radius*f.radius
+}
+
+func (f *fov) reveal(p Vec2i) {
+	if !f.seen[p] && f.inRadius(p) {
+		f.seen[p] = true
+		f.cells = append(f.cells, p)
+	}
+}
+
+// scan processes the row at depth between the slopes start and end, and
+// recurses into the rows behind it.
+func (f *fov) scan(depth int, start, end slope) {
+	if depth > f.radius {
+		return
+	}
+	// Columns whose centers lie between the slopes, rounding ties outward.
+	lo := FloorDiv(2*depth*start.num+start.den, 2*start.den)
+	hi := -FloorDiv(-(2*depth*end.num - end.den), 2*end.den)
+	prevOpaque, first := false, true
+	for col := lo; col <= hi; col++ {
+		p := f.cell(depth, col)
+		// Cells beyond the radius are never shown, and nothing within the
+		// radius lies in their shadow, so they count as floor.
+		isOpaque := f.inRadius(p) && f.opaque(p)
+		// Floor cells are visible only if their center is inside the
+		// slopes, which keeps visibility symmetric.
+		if isOpaque || (col*start.den >= depth*start.num && col*end.den <= depth*end.num) {
+			f.reveal(p)
+		}
+		edge := slope{2*col - 1, 2 * depth}
+		if !first && prevOpaque && !isOpaque {
+			start = edge
+		}
+		if !first && !prevOpaque && isOpaque {
+			f.scan(depth+1, start, edge)
+		}
+		prevOpaque, first = isOpaque, false
+	}
+	if !first && !prevOpaque {
+		f.scan(depth+1, start, end)
+	}
+}
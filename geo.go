@@ -0,0 +1,110 @@
+package vec
+
+import "math"
+
+// The functions below treat a Vec2 as a geographic position (lat, lon) in
+// degrees, with X the latitude north and Y the longitude east, on a
+// spherical Earth. The Vec3 forms add the altitude in meters as Z. The
+// sphere is accurate to about 0.5%, which suits games and maps but not
+// surveying.
+
+// EarthRadius is the mean radius of the Earth in meters.
+const EarthRadius = 6371008.8
+
+// HaversineDistance returns the great-circle distance in meters between
+// the positions a and b.
+func HaversineDistance(a, b Vec2) float64 {
+	lat1, lat2 := Radians(a.X), Radians(b.X)
+	dlat, dlon := lat2-lat1, Radians(b.Y-a.Y)
+	s1, s2 := math.Sin(dlat/2), math.Sin(dlon/2)
+	h := s1*s1 + math.Cos(lat1)*math.Cos(lat2)*s2*s2
+	return 2 * EarthRadius * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+// InitialBearing returns the direction in degrees clockwise from north, in
+// [0, 360), in which to set out from from along the great circle to to.
+// The bearing changes along the way unless the path follows a meridian or
+// the equator.
+func InitialBearing(from, to Vec2) float64 {
+	lat1, lat2 := Radians(from.X), Radians(to.X)
+	dlon := Radians(to.Y - from.Y)
+	y := math.Sin(dlon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dlon)
+	return math.Mod(Degrees(math.Atan2(y, x))+360, 360)
+}
+
+// DestinationPoint returns the position reached by going distance meters
+// from from along the great circle starting at bearing, in degrees
+// clockwise from north. The longitude is wrapped to [-180, 180).
+func DestinationPoint(from Vec2, bearing, distance float64) Vec2 {
+	lat1, lon1 := Radians(from.X), Radians(from.Y)
+	d := distance / EarthRadius
+	sb, cb := math.Sincos(Radians(bearing))
+	sd, cd := math.Sincos(d)
+	lat2 := math.Asin(math.Sin(lat1)*cd + math.Cos(lat1)*sd*cb)
+	lon2 := lon1 + math.Atan2(sb*sd*math.Cos(lat1), cd-math.Sin(lat1)*math.Sin(lat2))
+	return Vec2{Degrees(lat2), wrapLongitude(Degrees(lon2))}
+}
+
+// GeoToENU3 returns the position p relative to origin in the local
+// east-north-up frame at origin, in meters: X east, Y north and Z up,
+// the last measured from the tangent plane rather than the ground.
+func GeoToENU3(origin, p Vec3) Vec3 {
+	e, n, u := enuAxes(origin)
+	d := geoToECEF(p).Sub(geoToECEF(origin))
+	return Vec3{Dot3(d, e), Dot3(d, n), Dot3(d, u)}
+}
+
+// ENUToGeo3 returns the geographic position of enu, given in meters in the
+// local east-north-up frame at origin. It is the inverse of GeoToENU3.
+func ENUToGeo3(origin, enu Vec3) Vec3 {
+	e, n, u := enuAxes(origin)
+	r := geoToECEF(origin).Add(e.Scale(enu.X)).Add(n.Scale(enu.Y)).Add(u.Scale(enu.Z))
+	lat := Degrees(math.Atan2(r.Z, math.Hypot(r.X, r.Y)))
+	lon := Degrees(math.Atan2(r.Y, r.X))
+	return Vec3{lat, lon, Len3(r) - EarthRadius}
+}
+
+// GeoToENU2 returns the ground position p projected onto the tangent plane
+// at origin, in meters east (X) and north (Y) of origin. Distances are
+// close to true near origin and shrink with the curvature of the Earth
+// farther away.
+func GeoToENU2(origin, p Vec2) Vec2 {
+	v := GeoToENU3(Vec3{origin.X, origin.Y, 0}, Vec3{p.X, p.Y, 0})
+	return Vec2{v.X, v.Y}
+}
+
+// ENUToGeo2 returns the ground position that GeoToENU2 projects to enu.
+// Points farther than EarthRadius from origin have none and are clamped to
+// the horizon.
+func ENUToGeo2(origin, enu Vec2) Vec2 {
+	// Drop from the tangent plane down to the surface of the sphere.
+	up := math.Sqrt(max(EarthRadius*EarthRadius-LenSq2(enu), 0)) - EarthRadius
+	g := ENUToGeo3(Vec3{origin.X, origin.Y, 0}, Vec3{enu.X, enu.Y, up})
+	return Vec2{g.X, g.Y}
+}
+
+// geoToECEF returns the Earth-centered Cartesian position of p in meters,
+// with Z toward the north pole and X toward longitude 0.
+func geoToECEF(p Vec3) Vec3 {
+	slat, clat := math.Sincos(Radians(p.X))
+	slon, clon := math.Sincos(Radians(p.Y))
+	r := EarthRadius + p.Z
+	return Vec3{r * clat * clon, r * clat * slon, r * slat}
+}
+
+// enuAxes returns the unit east, north and up directions at p in the
+// Earth-centered frame of geoToECEF.
+func enuAxes(p Vec3) (e, n, u Vec3) {
+	slat, clat := math.Sincos(Radians(p.X))
+	slon, clon := math.Sincos(Radians(p.Y))
+	e = Vec3{-slon, clon, 0}
+	n = Vec3{-slat * clon, -slat * slon, clat}
+	u = Vec3{clat * clon, clat * slon, slat}
+	return e, n, u
+}
+
+// wrapLongitude returns lon in degrees wrapped to [-180, 180).
+func wrapLongitude(lon float64) float64 {
+	return math.Mod(math.Mod(lon+180, 360)+360, 360) - 180
+}
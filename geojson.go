@@ -0,0 +1,141 @@
+package vec
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The functions below encode and decode GeoJSON geometry objects (RFC
+// 7946). Positions are written as [X, Y], which GeoJSON reads as
+// [longitude, latitude], so positions in the (lat, lon) form used by
+// HaversineDistance must be swapped first. Decoding ignores altitudes.
+//
+// Polygon rings are written closed, repeating their first vertex at the
+// end as GeoJSON requires, and decoded back without the repeated vertex.
+// Rings are written in the winding they have; RFC 7946 recommends
+// counter-clockwise exterior rings and clockwise holes.
+
+type geoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// MarshalGeoJSONPoint returns the GeoJSON Point geometry at p.
+func MarshalGeoJSONPoint(p Vec2) ([]byte, error) {
+	return marshalGeoJSON("Point", geoJSONPosition(p))
+}
+
+// MarshalGeoJSONLineString returns the GeoJSON LineString geometry through
+// the points of path.
+func MarshalGeoJSONLineString(path []Vec2) ([]byte, error) {
+	return marshalGeoJSON("LineString", geoJSONPositions(path, false))
+}
+
+// MarshalGeoJSONPolygon returns the GeoJSON Polygon geometry with the given
+// rings: the exterior boundary followed by any holes.
+func MarshalGeoJSONPolygon(rings []Polygon) ([]byte, error) {
+	coords := make([][][2]float64, len(rings))
+	for i, r := range rings {
+		coords[i] = geoJSONPositions(r, true)
+	}
+	return marshalGeoJSON("Polygon", coords)
+}
+
+// UnmarshalGeoJSONPoint decodes a GeoJSON Point geometry.
+func UnmarshalGeoJSONPoint(data []byte) (Vec2, error) {
+	var pos []float64
+	if err := unmarshalGeoJSON(data, "Point", &pos); err != nil {
+		return Vec2{}, err
+	}
+	return geoJSONVec(pos)
+}
+
+// UnmarshalGeoJSONLineString decodes a GeoJSON LineString geometry.
+func UnmarshalGeoJSONLineString(data []byte) ([]Vec2, error) {
+	var pos [][]float64
+	if err := unmarshalGeoJSON(data, "LineString", &pos); err != nil {
+		return nil, err
+	}
+	return geoJSONVecs(pos, false)
+}
+
+// UnmarshalGeoJSONPolygon decodes a GeoJSON Polygon geometry into its
+// rings, the exterior boundary first.
+func UnmarshalGeoJSONPolygon(data []byte) ([]Polygon, error) {
+	var pos [][][]float64
+	if err := unmarshalGeoJSON(data, "Polygon", &pos); err != nil {
+		return nil, err
+	}
+	rings := make([]Polygon, len(pos))
+	for i, r := range pos {
+		ring, err := geoJSONVecs(r, true)
+		if err != nil {
+			return nil, err
+		}
+		rings[i] = ring
+	}
+	return rings, nil
+}
+
+func marshalGeoJSON(typ string, coords any) ([]byte, error) {
+	c, err := json.Marshal(coords)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(geoJSONGeometry{typ, c})
+}
+
+// unmarshalGeoJSON decodes the coordinates of the geometry in data into
+// coords, checking that it has type typ.
+func unmarshalGeoJSON(data []byte, typ string, coords any) error {
+	var g geoJSONGeometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return err
+	}
+	if g.Type != typ {
+		return fmt.Errorf("vec: GeoJSON geometry has type %q, want %q", g.Type, typ)
+	}
+	if g.Coordinates == nil {
+		return fmt.Errorf("vec: GeoJSON %s has no coordinates", typ)
+	}
+	return json.Unmarshal(g.Coordinates, coords)
+}
+
+func geoJSONPosition(p Vec2) [2]float64 { return [2]float64{p.X, p.Y} }
+
+// geoJSONPositions returns the positions of ps, repeating the first at the
+// end if closed.
+func geoJSONPositions(ps []Vec2, closed bool) [][2]float64 {
+	pos := make([][2]float64, 0, len(ps)+1)
+	for _, p := range ps {
+		pos = append(pos, geoJSONPosition(p))
+	}
+	if closed && len(ps) > 0 {
+		pos = append(pos, pos[0])
+	}
+	return pos
+}
+
+func geoJSONVec(pos []float64) (Vec2, error) {
+	if len(pos) < 2 {
+		return Vec2{}, fmt.Errorf("vec: GeoJSON position has %d components, want at least 2", len(pos))
+	}
+	return Vec2{pos[0], pos[1]}, nil
+}
+
+// geoJSONVecs decodes the positions pos, dropping the repeated last
+// position of a closed ring.
+func geoJSONVecs(pos [][]float64, closed bool) ([]Vec2, error) {
+	ps := make([]Vec2, 0, len(pos))
+	for _, p := range pos {
+		v, err := geoJSONVec(p)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, v)
+	}
+	if closed && len(ps) > 1 && ps[0] == ps[len(ps)-1] {
+		ps = ps[:len(ps)-1]
+	}
+	return ps, nil
+}
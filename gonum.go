@@ -0,0 +1,138 @@
+package vec
+
+// Conversions to and from flat float64 slices, the native storage of
+// numerical packages such as gonum. They need no dependency: pass the
+// results to mat.NewVecDense or mat.NewDense, and pass a mat.Vector to
+// FromVector2/3/4 directly.
+
+// Float64s2 returns the components of v as a new []float64.
+func Float64s2[V Vec2like[S], S Scalar](v V) []float64 {
+	vv := Vec2g[S](v)
+	return []float64{float64(vv.X), float64(vv.Y)}
+}
+
+// Float64s3 returns the components of v as a new []float64.
+func Float64s3[V Vec3like[S], S Scalar](v V) []float64 {
+	vv := Vec3g[S](v)
+	return []float64{float64(vv.X), float64(vv.Y), float64(vv.Z)}
+}
+
+// Float64s4 returns the components of v as a new []float64.
+func Float64s4[V Vec4like[S], S Scalar](v V) []float64 {
+	vv := Vec4g[S](v)
+	return []float64{float64(vv.X), float64(vv.Y), float64(vv.Z), float64(vv.W)}
+}
+
+// FromFloat64s2 returns the first two elements of s as a vector.
+// It panics if s is shorter.
+func FromFloat64s2(s []float64) Vec2 { return Vec2{s[0], s[1]} }
+
+// FromFloat64s3 returns the first three elements of s as a vector.
+// It panics if s is shorter.
+func FromFloat64s3(s []float64) Vec3 { return Vec3{s[0], s[1], s[2]} }
+
+// FromFloat64s4 returns the first four elements of s as a vector.
+// It panics if s is shorter.
+func FromFloat64s4(s []float64) Vec4 { return Vec4{s[0], s[1], s[2], s[3]} }
+
+// FromVector2 returns the first two elements of v, such as a gonum
+// mat.Vector, as a vector.
+func FromVector2(v interface{ AtVec(int) float64 }) Vec2 {
+	return Vec2{v.AtVec(0), v.AtVec(1)}
+}
+
+// FromVector3 returns the first three elements of v as a vector.
+func FromVector3(v interface{ AtVec(int) float64 }) Vec3 {
+	return Vec3{v.AtVec(0), v.AtVec(1), v.AtVec(2)}
+}
+
+// FromVector4 returns the first four elements of v as a vector.
+func FromVector4(v interface{ AtVec(int) float64 }) Vec4 {
+	return Vec4{v.AtVec(0), v.AtVec(1), v.AtVec(2), v.AtVec(3)}
+}
+
+// Columns2 returns the row-major data of the 2×len(vs) matrix whose columns
+// are vs, as taken by mat.NewDense(2, len(vs), data).
+func Columns2[V Vec2like[S], S Scalar](vs []V) []float64 {
+	n := len(vs)
+	data := make([]float64, 2*n)
+	for j, v := range vs {
+		vv := Vec2g[S](v)
+		data[j], data[n+j] = float64(vv.X), float64(vv.Y)
+	}
+	return data
+}
+
+// Columns3 returns the row-major data of the 3×len(vs) matrix whose columns
+// are vs, as taken by mat.NewDense(3, len(vs), data).
+func Columns3[V Vec3like[S], S Scalar](vs []V) []float64 {
+	n := len(vs)
+	data := make([]float64, 3*n)
+	for j, v := range vs {
+		vv := Vec3g[S](v)
+		data[j], data[n+j], data[2*n+j] = float64(vv.X), float64(vv.Y), float64(vv.Z)
+	}
+	return data
+}
+
+// Rows2 returns the row-major data of the len(vs)×2 matrix whose rows are vs.
+func Rows2[V Vec2like[S], S Scalar](vs []V) []float64 {
+	data := make([]float64, 0, 2*len(vs))
+	for _, v := range vs {
+		vv := Vec2g[S](v)
+		data = append(data, float64(vv.X), float64(vv.Y))
+	}
+	return data
+}
+
+// Rows3 returns the row-major data of the len(vs)×3 matrix whose rows are vs.
+func Rows3[V Vec3like[S], S Scalar](vs []V) []float64 {
+	data := make([]float64, 0, 3*len(vs))
+	for _, v := range vs {
+		vv := Vec3g[S](v)
+		data = append(data, float64(vv.X), float64(vv.Y), float64(vv.Z))
+	}
+	return data
+}
+
+// FromColumns2 is the inverse of Columns2, splitting the row-major data of
+// a 2×n matrix into its columns.
+func FromColumns2(data []float64) []Vec2 {
+	n := len(data) / 2
+	vs := make([]Vec2, n)
+	for j := range vs {
+		vs[j] = Vec2{data[j], data[n+j]}
+	}
+	return vs
+}
+
+// FromColumns3 is the inverse of Columns3, splitting the row-major data of
+// a 3×n matrix into its columns.
+func FromColumns3(data []float64) []Vec3 {
+	n := len(data) / 3
+	vs := make([]Vec3, n)
+	for j := range vs {
+		vs[j] = Vec3{data[j], data[n+j], data[2*n+j]}
+	}
+	return vs
+}
+
+// FromRows2 is the inverse of Rows2, splitting the row-major data of an
+// n×2 matrix into its rows.
+func FromRows2(data []float64) []Vec2 {
+	vs := make([]Vec2, len(data)/2)
+	for i := range vs {
+		vs[i] = Vec2{data[2*i], data[2*i+1]}
+	}
+	return vs
+}
+
+// FromRows3 is the inverse of Rows3, splitting the row-major data of an
+// n×3 matrix into its rows.
+func FromRows3(data []float64) []Vec3 {
+	vs := make([]Vec3, len(data)/3)
+	for i := range vs {
+		vs[i] = Vec3{data[3*i], data[3*i+1], data[3*i+2]}
+	}
+	return vs
+}
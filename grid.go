@@ -0,0 +1,128 @@
+package vec
+
+import "iter"
+
+// Grid is a dense 2D grid of values indexed by integer cell coordinates
+// in [0, Size). Cells are stored in row-major order: X varies fastest, then Y.
+type Grid[T any] struct {
+	Size  Vec2i
+	Cells []T
+}
+
+// NewGrid returns a grid of the given size with all cells set to the zero value.
+func NewGrid[T any](size Vec2i) *Grid[T] {
+	return &Grid[T]{size, make([]T, size.X*size.Y)}
+}
+
+// In reports whether p lies inside the grid.
+func (g *Grid[T]) In(p Vec2i) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < g.Size.X && p.Y < g.Size.Y
+}
+
+// Index returns the position of cell p in Cells.
+func (g *Grid[T]) Index(p Vec2i) int { return p.X + g.Size.X*p.Y }
+
+// At returns the value of cell p. It panics if p is outside the grid.
+func (g *Grid[T]) At(p Vec2i) T {
+	if !g.In(p) {
+		panic("vec: Grid index out of range")
+	}
+	return g.Cells[g.Index(p)]
+}
+
+// Get returns the value of cell p, or the zero value and false if p is
+// outside the grid.
+func (g *Grid[T]) Get(p Vec2i) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.Cells[g.Index(p)], true
+}
+
+// Set sets the value of cell p. It panics if p is outside the grid.
+func (g *Grid[T]) Set(p Vec2i, v T) {
+	if !g.In(p) {
+		panic("vec: Grid index out of range")
+	}
+	g.Cells[g.Index(p)] = v
+}
+
+// Fill sets every cell to v.
+func (g *Grid[T]) Fill(v T) {
+	for i := range g.Cells {
+		g.Cells[i] = v
+	}
+}
+
+// All returns an iterator over the cells and their values in row-major order.
+func (g *Grid[T]) All() iter.Seq2[Vec2i, T] {
+	return func(yield func(Vec2i, T) bool) {
+		for i, v := range g.Cells {
+			if !yield(Vec2i{i % g.Size.X, i / g.Size.X}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iterator over the cells adjacent to p that lie
+// inside the grid, and their values. It visits the 4 edge neighbors, or
+// all 8 surrounding cells if diagonal is true.
+func (g *Grid[T]) Neighbors(p Vec2i, diagonal bool) iter.Seq2[Vec2i, T] {
+	dirs := gridDirs4[:]
+	if diagonal {
+		dirs = gridDirs8[:]
+	}
+	return func(yield func(Vec2i, T) bool) {
+		for _, d := range dirs {
+			q := p.Add(d)
+			if g.In(q) && !yield(q, g.Cells[g.Index(q)]) {
+				return
+			}
+		}
+	}
+}
+
+var (
+	// gridDirs4 lists the edge neighbor offsets counter-clockwise from +X.
+	gridDirs4 = [4]Vec2i{{1, 0}, {0, 1}, {-1, 0}, {0, -1}}
+	// gridDirs8 lists all neighbor offsets counter-clockwise from +X.
+	gridDirs8 = [8]Vec2i{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}}
+)
+
+// Grid3 is a dense 3D grid of values indexed by integer cell coordinates
+// in [0, Size). Cells are stored in X-major order: X varies fastest, then Y, then Z.
+type Grid3[T any] struct {
+	Size  Vec3i
+	Cells []T
+}
+
+// NewGrid3 returns a grid of the given size with all cells set to the zero value.
+func NewGrid3[T any](size Vec3i) *Grid3[T] {
+	return &Grid3[T]{size, make([]T, size.X*size.Y*size.Z)}
+}
+
+// In reports whether p lies inside the grid.
+func (g *Grid3[T]) In(p Vec3i) bool {
+	return p.X >= 0 && p.Y >= 0 && p.Z >= 0 && p.X < g.Size.X && p.Y < g.Size.Y && p.Z < g.Size.Z
+}
+
+// Index returns the position of cell p in Cells.
+func (g *Grid3[T]) Index(p Vec3i) int { return p.X + g.Size.X*(p.Y+g.Size.Y*p.Z) }
+
+// At returns the value of cell p. It panics if p is outside the grid.
+func (g *Grid3[T]) At(p Vec3i) T {
+	if !g.In(p) {
+		panic("vec: Grid3 index out of range")
+	}
+	return g.Cells[g.Index(p)]
+}
+
+// Set sets the value of cell p. It panics if p is outside the grid.
+func (g *Grid3[T]) Set(p Vec3i, v T) {
+	if !g.In(p) {
+		panic("vec: Grid3 index out of range")
+	}
+	g.Cells[g.Index(p)] = v
+}
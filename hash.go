@@ -0,0 +1,120 @@
+package vec
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+)
+
+// Vectors are comparable, so they can be used directly as map keys and
+// hashed with maphash.Comparable. The functions here complement that with
+// hashes that are deterministic across runs, suitable for spatial hashing,
+// and that treat -0 and +0 alike. They are not resistant to adversarial
+// input; use maphash with a random seed for untrusted keys.
+
+// Hash2 returns a well-distributed 64-bit hash of v.
+// Equal vectors have equal hashes, with -0 and +0 considered equal.
+func Hash2[V Vec2like[S], S Scalar](v V) uint64 {
+	va := Vec2g[S](v)
+	return hashBits(hashBits(hashSeed, componentBits(va.X)), componentBits(va.Y))
+}
+
+// Hash3 returns a well-distributed 64-bit hash of v.
+// Equal vectors have equal hashes, with -0 and +0 considered equal.
+func Hash3[V Vec3like[S], S Scalar](v V) uint64 {
+	va := Vec3g[S](v)
+	h := hashBits(hashSeed, componentBits(va.X))
+	h = hashBits(h, componentBits(va.Y))
+	return hashBits(h, componentBits(va.Z))
+}
+
+// Hash4 returns a well-distributed 64-bit hash of v.
+// Equal vectors have equal hashes, with -0 and +0 considered equal.
+func Hash4[V Vec4like[S], S Scalar](v V) uint64 {
+	va := Vec4g[S](v)
+	h := hashBits(hashSeed, componentBits(va.X))
+	h = hashBits(h, componentBits(va.Y))
+	h = hashBits(h, componentBits(va.Z))
+	return hashBits(h, componentBits(va.W))
+}
+
+// HashQuantized2 returns the hash of the grid cell of size cell containing v,
+// so that positions within the same cell hash equally regardless of
+// rounding noise. Nearby positions on different sides of a cell boundary
+// hash differently. It panics if cell is not positive.
+func HashQuantized2(v Vec2, cell float64) uint64 {
+	checkHashCell(cell)
+	return Hash2(Vec2i{int(math.Floor(v.X / cell)), int(math.Floor(v.Y / cell))})
+}
+
+// HashQuantized3 returns the hash of the grid cell of size cell containing v.
+// See HashQuantized2.
+func HashQuantized3(v Vec3, cell float64) uint64 {
+	checkHashCell(cell)
+	return Hash3(Vec3i{int(math.Floor(v.X / cell)), int(math.Floor(v.Y / cell)), int(math.Floor(v.Z / cell))})
+}
+
+func checkHashCell(cell float64) {
+	if !(cell > 0) {
+		panic("vec: HashQuantized cell size must be positive")
+	}
+}
+
+// WriteHash2 writes the components of v to h, for hashing composite keys
+// that contain vectors. -0 and +0 are written identically.
+func WriteHash2[V Vec2like[S], S Scalar](h *maphash.Hash, v V) {
+	va := Vec2g[S](v)
+	var b [16]byte
+	binary.LittleEndian.PutUint64(b[0:], componentBits(va.X))
+	binary.LittleEndian.PutUint64(b[8:], componentBits(va.Y))
+	h.Write(b[:])
+}
+
+// WriteHash3 writes the components of v to h, for hashing composite keys
+// that contain vectors. -0 and +0 are written identically.
+func WriteHash3[V Vec3like[S], S Scalar](h *maphash.Hash, v V) {
+	va := Vec3g[S](v)
+	var b [24]byte
+	binary.LittleEndian.PutUint64(b[0:], componentBits(va.X))
+	binary.LittleEndian.PutUint64(b[8:], componentBits(va.Y))
+	binary.LittleEndian.PutUint64(b[16:], componentBits(va.Z))
+	h.Write(b[:])
+}
+
+// WriteHash4 writes the components of v to h, for hashing composite keys
+// that contain vectors. -0 and +0 are written identically.
+func WriteHash4[V Vec4like[S], S Scalar](h *maphash.Hash, v V) {
+	va := Vec4g[S](v)
+	var b [32]byte
+	binary.LittleEndian.PutUint64(b[0:], componentBits(va.X))
+	binary.LittleEndian.PutUint64(b[8:], componentBits(va.Y))
+	binary.LittleEndian.PutUint64(b[16:], componentBits(va.Z))
+	binary.LittleEndian.PutUint64(b[24:], componentBits(va.W))
+	h.Write(b[:])
+}
+
+const hashSeed = 0x9e3779b97f4a7c15
+
+// componentBits returns a 64-bit pattern identifying x.
+// Floats are widened to float64, which is exact, and -0 maps to +0.
+func componentBits[S Scalar](x S) uint64 {
+	if isFloat[S]() {
+		return math.Float64bits(float64(x) + 0)
+	}
+	return uint64(x)
+}
+
+// isFloat reports whether S is a floating-point type.
+func isFloat[S Scalar]() bool {
+	var half S = 1
+	half /= 2
+	return half != 0
+}
+
+// hashBits mixes x into the running hash h using the splitmix64 finalizer.
+func hashBits(h, x uint64) uint64 {
+	h ^= x
+	h = (h ^ (h >> 30)) * 0xbf58476d1ce4e5b9
+	h = (h ^ (h >> 27)) * 0x94d049bb133111eb
+	return (h ^ (h >> 31)) + hashSeed
+}
@@ -0,0 +1,153 @@
+package vec
+
+import "math"
+
+// Heightmap is a terrain surface given by heights sampled on a grid. The
+// sample of cell (i, j) lies at (i, j) times CellSize on the XY plane,
+// and heights rise along +Z. Between samples the surface is interpolated
+// bilinearly; outside the grid it continues the heights at the edges.
+// The grid must have at least one sample; queries on an empty heightmap
+// panic.
+type Heightmap struct {
+	Grid[float64]
+	CellSize float64
+}
+
+// NewHeightmap returns a flat heightmap with size samples spaced cellSize apart.
+func NewHeightmap(size Vec2i, cellSize float64) *Heightmap {
+	return &Heightmap{*NewGrid[float64](size), cellSize}
+}
+
+// Bounds returns the rectangle of the XY plane covered by the samples.
+func (h *Heightmap) Bounds() Rect {
+	return Rect{Max: As2[float64](h.Size.Subs(1)).Scale(h.CellSize)}
+}
+
+// cell returns the sample cell containing p and the position of p within
+// it, in [0, 1].
+func (h *Heightmap) cell(p Vec2) (Vec2i, Vec2) {
+	g := p.Scale(1 / h.CellSize)
+	maxCell := Vec2i{max(h.Size.X-2, 0), max(h.Size.Y-2, 0)}
+	c := Vec2i{int(math.Floor(g.X)), int(math.Floor(g.Y))}
+	c = Vec2i{min(max(c.X, 0), maxCell.X), min(max(c.Y, 0), maxCell.Y)}
+	f := g.Sub(As2[float64](c))
+	return c, Vec2{min(max(f.X, 0), 1), min(max(f.Y, 0), 1)}
+}
+
+// checkSize panics if h has no samples.
+func (h *Heightmap) checkSize() {
+	if h.Size.X < 1 || h.Size.Y < 1 {
+		panic("vec: Heightmap has no samples")
+	}
+}
+
+// corners returns the heights at the corners of cell c, clamping to the grid.
+func (h *Heightmap) corners(c Vec2i) (h00, h10, h01, h11 float64) {
+	h.checkSize()
+	at := func(x, y int) float64 {
+		x, y = min(x, h.Size.X-1), min(y, h.Size.Y-1)
+		return h.Cells[h.Index(Vec2i{x, y})]
+	}
+	return at(c.X, c.Y), at(c.X+1, c.Y), at(c.X, c.Y+1), at(c.X+1, c.Y+1)
+}
+
+// HeightAt returns the height of the surface above p.
+func (h *Heightmap) HeightAt(p Vec2) float64 {
+	c, f := h.cell(p)
+	h00, h10, h01, h11 := h.corners(c)
+	return lerp(lerp(h00, h10, f.X), lerp(h01, h11, f.X), f.Y)
+}
+
+// GradientAt returns the rate of change of height along X and Y at p,
+// pointing uphill. It is zero outside the grid, where the surface is flat.
+func (h *Heightmap) GradientAt(p Vec2) Vec2 {
+	c, f := h.cell(p)
+	h00, h10, h01, h11 := h.corners(c)
+	g := Vec2{
+		lerp(h10-h00, h11-h01, f.Y),
+		lerp(h01-h00, h11-h10, f.X),
+	}.Scale(1 / h.CellSize)
+	if b := h.Bounds(); p.X < b.Min.X || p.X > b.Max.X {
+		g.X = 0
+	}
+	if b := h.Bounds(); p.Y < b.Min.Y || p.Y > b.Max.Y {
+		g.Y = 0
+	}
+	return g
+}
+
+// SlopeAt returns the steepness of the surface at p as an angle in radians
+// from horizontal, as for deciding where a character can walk.
+func (h *Heightmap) SlopeAt(p Vec2) float64 { return math.Atan(Len2(h.GradientAt(p))) }
+
+// NormalAt returns the unit upward normal of the surface at p.
+func (h *Heightmap) NormalAt(p Vec2) Vec3 {
+	g := h.GradientAt(p)
+	return Normalize3(Vec3{0 - g.X, 0 - g.Y, 1}) // 0 - x avoids a negative zero
+}
+
+// Raycast returns the first point where r meets the surface over the
+// bounds of h, and its distance along r in units of r.Dir. It steps along
+// r half a cell at a time and refines the crossing by bisection, so spikes
+// narrower than half a cell may be missed. A ray starting below the
+// surface hits where it enters the bounds. Returns false if r misses.
+func (h *Heightmap) Raycast(r Ray3) (p Vec3, t float64, ok bool) {
+	h.checkSize()
+	// Clip r to the bounds of h on the XY plane.
+	lo, hi := 0.0, math.Inf(1)
+	b := h.Bounds()
+	for i := range 2 {
+		o, d := r.Origin.At(i), r.Dir.At(i)
+		if d == 0 {
+			if o < b.Min.At(i) || o > b.Max.At(i) {
+				return p, 0, false
+			}
+			continue
+		}
+		t0, t1 := (b.Min.At(i)-o)/d, (b.Max.At(i)-o)/d
+		lo, hi = math.Max(lo, math.Min(t0, t1)), math.Min(hi, math.Max(t0, t1))
+	}
+	if lo > hi {
+		return p, 0, false
+	}
+
+	above := func(t float64) float64 {
+		q := r.Origin.Add(r.Dir.Scale(t))
+		return q.Z - h.HeightAt(Vec2{q.X, q.Y})
+	}
+	if math.IsInf(hi, 1) {
+		// A vertical ray: it meets the surface where its height matches.
+		q := Vec2{r.Origin.X, r.Origin.Y}
+		t = (h.HeightAt(q) - r.Origin.Z) / r.Dir.Z
+		if r.Dir.Z == 0 || t < 0 {
+			return p, 0, false
+		}
+		return Vec3{q.X, q.Y, h.HeightAt(q)}, t, true
+	}
+
+	step := h.CellSize / 2 / math.Hypot(r.Dir.X, r.Dir.Y)
+	prev := lo
+	if above(prev) <= 0 {
+		return r.Origin.Add(r.Dir.Scale(prev)), prev, true
+	}
+	for t := lo; t < hi; {
+		t = math.Min(t+step, hi)
+		if above(t) <= 0 {
+			a, b := prev, t
+			for range 32 {
+				m := (a + b) / 2
+				if above(m) <= 0 {
+					b = m
+				} else {
+					a = m
+				}
+			}
+			return r.Origin.Add(r.Dir.Scale(b)), b, true
+		}
+		prev = t
+	}
+	return p, 0, false
+}
+
+// lerp linearly interpolates between a and b by t.
+func lerp(a, b, t float64) float64 { return a + (b-a)*t }
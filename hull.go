@@ -0,0 +1,32 @@
+package vec
+
+import "slices"
+
+// ConvexHull2 returns the convex hull of points in counter-clockwise order,
+// starting from the lowest-leftmost point. Collinear points on the hull
+// boundary are omitted. points is not modified.
+func ConvexHull2(points []Vec2) Polygon {
+	ps := slices.Clone(points)
+	slices.SortFunc(ps, Compare2)
+	ps = slices.Compact(ps)
+	if len(ps) < 3 {
+		return Polygon(ps)
+	}
+
+	// Andrew's monotone chain: build the lower hull, then the upper hull.
+	hull := make(Polygon, 0, 2*len(ps))
+	for pass := range 2 {
+		start := len(hull)
+		for _, p := range ps {
+			for len(hull) >= start+2 && Orient2D(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		hull = hull[:len(hull)-1] // the last point starts the other chain
+		if pass == 0 {
+			slices.Reverse(ps)
+		}
+	}
+	return hull
+}
@@ -0,0 +1,61 @@
+package vec
+
+import "math"
+
+// ICP2 aligns src to dst without known correspondences using the iterative
+// closest point algorithm: each src point is paired with its nearest dst
+// point, the best rigid transform for those pairs is solved with
+// AlignPointSets2, and the process repeats until the root-mean-square
+// distance improves by less than tol or maxIter iterations have run.
+// The result maps src onto dst as rot.MulVec(p) + t.
+// ICP converges to a local minimum, so src should start roughly aligned.
+func ICP2(src, dst []Vec2, maxIter int, tol float64) (rot Mat2, t Vec2, rms float64) {
+	rot = Identity2()
+	if len(src) == 0 || len(dst) == 0 {
+		return rot, t, 0
+	}
+	tree := NewKDTree2(dst)
+	matched := make([]Vec2, len(src))
+	rms = math.Inf(1)
+	for iter := 0; ; iter++ {
+		var sum float64
+		for i, p := range src {
+			j, d := tree.Nearest(rot.MulVec(p).Add(t))
+			matched[i] = dst[j]
+			sum += d
+		}
+		err := math.Sqrt(sum / float64(len(src)))
+		if iter == maxIter || rms-err < tol {
+			return rot, t, err
+		}
+		rms = err
+		rot, t, _ = AlignPointSets2(src, matched, false)
+	}
+}
+
+// ICP3 aligns src to dst without known correspondences using the iterative
+// closest point algorithm. See ICP2 for details.
+// The result maps src onto dst as rot.MulVec(p) + t.
+func ICP3(src, dst []Vec3, maxIter int, tol float64) (rot Mat3, t Vec3, rms float64) {
+	rot = Identity3()
+	if len(src) == 0 || len(dst) == 0 {
+		return rot, t, 0
+	}
+	tree := NewKDTree3(dst)
+	matched := make([]Vec3, len(src))
+	rms = math.Inf(1)
+	for iter := 0; ; iter++ {
+		var sum float64
+		for i, p := range src {
+			j, d := tree.Nearest(rot.MulVec(p).Add(t))
+			matched[i] = dst[j]
+			sum += d
+		}
+		err := math.Sqrt(sum / float64(len(src)))
+		if iter == maxIter || rms-err < tol {
+			return rot, t, err
+		}
+		rms = err
+		rot, t, _ = AlignPointSets3(src, matched, false)
+	}
+}
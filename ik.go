@@ -0,0 +1,98 @@
+package vec
+
+import "math"
+
+// TwoBoneIK solves a two-bone chain, such as an arm or a leg, so that the
+// end reaches target. The root stays fixed and both bone lengths are kept.
+// The middle joint bends toward the pole point poleHint, or keeps its
+// current side if poleHint lies on the line from root to target. A target
+// out of reach leaves the chain fully stretched toward it.
+func TwoBoneIK(root, mid, end, target, poleHint Vec3) (newMid, newEnd Vec3) {
+	l1, l2 := Len3(mid.Sub(root)), Len3(end.Sub(mid))
+	toTarget := target.Sub(root)
+	d := Len3(toTarget)
+	if d == 0 {
+		return mid, end
+	}
+	dir := toTarget.Divs(d)
+	d = max(math.Abs(l1-l2), min(l1+l2, d))
+
+	// Law of cosines: the middle joint lies a along dir and h to the side.
+	a := (l1*l1 - l2*l2 + d*d) / (2 * d)
+	h := math.Sqrt(max(0, l1*l1-a*a))
+	bend := perpendicularTo(poleHint.Sub(root), dir)
+	if bend == (Vec3{}) {
+		bend = perpendicularTo(mid.Sub(root), dir)
+	}
+	if bend == (Vec3{}) {
+		bend = anyPerpendicular3(dir)
+	}
+	newMid = root.Add(dir.Muls(a)).Add(bend.Muls(h))
+	newEnd = root.Add(dir.Muls(d))
+	return newMid, newEnd
+}
+
+// FABRIK moves the chain of joints so that its last joint reaches target,
+// using forward and backward reaching inverse kinematics. The first joint
+// stays fixed and the distances between consecutive joints are kept. It
+// iterates until the end is within tol of target or maxIter passes have
+// run, and returns the new joint positions without modifying joints.
+// A target out of reach leaves the chain stretched straight toward it.
+func FABRIK(joints []Vec3, target Vec3, maxIter int, tol float64) []Vec3 {
+	n := len(joints)
+	p := append([]Vec3(nil), joints...)
+	if n < 2 {
+		return p
+	}
+	lengths := make([]float64, n-1)
+	var total float64
+	for i := range lengths {
+		lengths[i] = Len3(p[i+1].Sub(p[i]))
+		total += lengths[i]
+	}
+
+	root := p[0]
+	if Len3(target.Sub(root)) >= total {
+		dir := Normalize3(target.Sub(root))
+		for i := range lengths {
+			p[i+1] = p[i].Add(dir.Muls(lengths[i]))
+		}
+		return p
+	}
+
+	for range maxIter {
+		if Len3(p[n-1].Sub(target)) <= tol {
+			break
+		}
+		// Backward: pin the end to the target and pull the chain after it.
+		p[n-1] = target
+		for i := n - 2; i >= 0; i-- {
+			p[i] = p[i+1].Add(Normalize3(p[i].Sub(p[i+1])).Muls(lengths[i]))
+		}
+		// Forward: pin the root back in place.
+		p[0] = root
+		for i := range lengths {
+			p[i+1] = p[i].Add(Normalize3(p[i+1].Sub(p[i])).Muls(lengths[i]))
+		}
+	}
+	return p
+}
+
+// perpendicularTo returns the unit component of v perpendicular to the unit
+// vector dir, or the zero vector if v is parallel to dir.
+func perpendicularTo(v, dir Vec3) Vec3 {
+	v = v.Sub(dir.Muls(Dot3(v, dir)))
+	if LenSq3(v) < 1e-18 {
+		return Vec3{}
+	}
+	return Normalize3(v)
+}
+
+// anyPerpendicular3 returns a unit vector perpendicular to the unit vector v.
+func anyPerpendicular3(v Vec3) Vec3 {
+	axis := Vec3{1, 0, 0}
+	if math.Abs(v.X) > 0.9 {
+		axis = Vec3{0, 1, 0}
+	}
+	return Normalize3(Cross3(v, axis))
+}
@@ -0,0 +1,54 @@
+package vec
+
+// FloorDiv returns a/b rounded toward negative infinity, unlike Go's
+// division, which truncates toward zero. So FloorDiv(-1, 16) is -1, the
+// tile containing position -1 for tiles 16 wide. It panics if b is zero.
+func FloorDiv[S Signed](a, b S) S {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// EuclidMod returns a modulo b in [0, |b|), unlike Go's %, which takes the
+// sign of a. For b > 0, a == FloorDiv(a, b)*b + EuclidMod(a, b), which
+// gives the position of a within its tile. It panics if b is zero.
+func EuclidMod[S Signed](a, b S) S {
+	r := a % b
+	if r < 0 {
+		if b < 0 {
+			return r - b
+		}
+		return r + b
+	}
+	return r
+}
+
+// FloorDiv2 divides each component of v by the corresponding component
+// of d, rounding toward negative infinity. See FloorDiv.
+func FloorDiv2[V1, V2 Vec2like[S], S Signed](v V1, d V2) V1 {
+	va, vd := Vec2g[S](v), Vec2g[S](d)
+	return V1(Vec2g[S]{FloorDiv(va.X, vd.X), FloorDiv(va.Y, vd.Y)})
+}
+
+// FloorDiv3 divides each component of v by the corresponding component
+// of d, rounding toward negative infinity. See FloorDiv.
+func FloorDiv3[V1, V2 Vec3like[S], S Signed](v V1, d V2) V1 {
+	va, vd := Vec3g[S](v), Vec3g[S](d)
+	return V1(Vec3g[S]{FloorDiv(va.X, vd.X), FloorDiv(va.Y, vd.Y), FloorDiv(va.Z, vd.Z)})
+}
+
+// EuclidMod2 returns each component of v modulo the corresponding
+// component of d, in [0, |d|). See EuclidMod.
+func EuclidMod2[V1, V2 Vec2like[S], S Signed](v V1, d V2) V1 {
+	va, vd := Vec2g[S](v), Vec2g[S](d)
+	return V1(Vec2g[S]{EuclidMod(va.X, vd.X), EuclidMod(va.Y, vd.Y)})
+}
+
+// EuclidMod3 returns each component of v modulo the corresponding
+// component of d, in [0, |d|). See EuclidMod.
+func EuclidMod3[V1, V2 Vec3like[S], S Signed](v V1, d V2) V1 {
+	va, vd := Vec3g[S](v), Vec3g[S](d)
+	return V1(Vec3g[S]{EuclidMod(va.X, vd.X), EuclidMod(va.Y, vd.Y), EuclidMod(va.Z, vd.Z)})
+}
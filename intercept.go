@@ -0,0 +1,40 @@
+package vec
+
+import "math"
+
+// InterceptPoint returns where a projectile fired from shooter at the given
+// speed meets a target at target moving with constant velocity targetVel,
+// and when. Aiming at the point leads the target. When there are two
+// solutions the earlier one is returned. Returns false if the projectile
+// can never catch the target. V is Vec2 or Vec3.
+func InterceptPoint[V Vector[V, float64]](shooter V, speed float64, target, targetVel V) (aim V, t float64, ok bool) {
+	// Solve |r + targetVel*t| = speed*t for the smallest t >= 0.
+	r := target.Sub(shooter)
+	a := Dot(targetVel, targetVel) - speed*speed
+	b := 2 * Dot(r, targetVel)
+	c := Dot(r, r)
+	if c == 0 {
+		return target, 0, true
+	}
+
+	t = -1
+	if math.Abs(a) < 1e-12*max(1, speed*speed) {
+		// The target moves as fast as the projectile.
+		if b < 0 {
+			t = -c / b
+		}
+	} else if disc := b*b - 4*a*c; disc >= 0 {
+		sq := math.Sqrt(disc)
+		t1, t2 := (-b-sq)/(2*a), (-b+sq)/(2*a)
+		t1, t2 = min(t1, t2), max(t1, t2)
+		if t1 >= 0 {
+			t = t1
+		} else {
+			t = t2
+		}
+	}
+	if t < 0 {
+		return aim, 0, false
+	}
+	return target.Add(targetVel.Scale(t)), t, true
+}
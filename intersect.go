@@ -0,0 +1,197 @@
+package vec
+
+import (
+	"fmt"
+	"math"
+)
+
+// Segment2 is a line segment from A to B.
+type Segment2 struct{ A, B Vec2 }
+
+// Line2 is an infinite line through Point along Dir.
+// Dir need not be normalized but must be non-zero.
+type Line2 struct{ Point, Dir Vec2 }
+
+// Ray2 is a half-line starting at Origin and extending along Dir.
+// Dir need not be normalized but must be non-zero.
+type Ray2 struct{ Origin, Dir Vec2 }
+
+// Ray3 is a half-line starting at Origin and extending along Dir.
+// Dir need not be normalized but must be non-zero.
+type Ray3 struct{ Origin, Dir Vec3 }
+
+// Plane is the set of points p satisfying Dot3(Normal, p) == D.
+// Normal is expected to be a unit vector.
+type Plane struct {
+	Normal Vec3
+	D      float64
+}
+
+// Distance returns the perpendicular distance from p to l.
+func (l Line2) Distance(p Vec2) float64 {
+	return math.Abs(Cross2(l.Dir, p.Sub(l.Point))) / Len2(l.Dir)
+}
+
+// Distance returns the signed distance from p to pl,
+// positive on the side the normal points to.
+func (pl Plane) Distance(p Vec3) float64 { return Dot3(pl.Normal, p) - pl.D }
+
+// IntersectCircles returns the intersection points of circles a and b.
+// n is the number of points found: 0 if the circles are separate, nested or
+// concentric, 1 if they touch, and 2 otherwise. Unused points are zero.
+// For two points, p1 lies to the left of the line from a's center to b's center.
+func IntersectCircles(a, b Circle) (p1, p2 Vec2, n int) {
+	d := b.Center.Sub(a.Center)
+	dist := Len2(d)
+	if dist == 0 || dist > a.Radius+b.Radius || dist < math.Abs(a.Radius-b.Radius) {
+		return Vec2{}, Vec2{}, 0
+	}
+	// Distance from a.Center to the chord's midpoint along d.
+	along := (a.Radius*a.Radius - b.Radius*b.Radius + dist*dist) / (2 * dist)
+	h2 := a.Radius*a.Radius - along*along
+	u := d.Divs(dist)
+	mid := a.Center.Add(u.Scale(along))
+	if h2 <= 0 {
+		return mid, Vec2{}, 1
+	}
+	h := math.Sqrt(h2)
+	perp := Vec2{-u.Y, u.X}.Scale(h)
+	return mid.Add(perp), mid.Sub(perp), 2
+}
+
+// IntersectCircleLine returns the intersection points of circle c and line l.
+// n is the number of points found: 0, 1 if the line is tangent, or 2.
+// Points are ordered along l.Dir. Unused points are zero.
+func IntersectCircleLine(c Circle, l Line2) (p1, p2 Vec2, n int) {
+	t1, t2, n := circleLineParams(c, l.Point, l.Dir)
+	switch n {
+	case 0:
+		return Vec2{}, Vec2{}, 0
+	case 1:
+		return l.Point.Add(l.Dir.Scale(t1)), Vec2{}, 1
+	}
+	return l.Point.Add(l.Dir.Scale(t1)), l.Point.Add(l.Dir.Scale(t2)), 2
+}
+
+// IntersectCircleSegment returns the intersection points of circle c and segment s.
+// n is the number of points found: 0, 1, or 2.
+// Points are ordered from s.A to s.B. Unused points are zero.
+func IntersectCircleSegment(c Circle, s Segment2) (p1, p2 Vec2, n int) {
+	d := s.B.Sub(s.A)
+	t1, t2, m := circleLineParams(c, s.A, d)
+	var ps [2]Vec2
+	ts := [2]float64{t1, t2}
+	for _, t := range ts[:m] {
+		if t >= 0 && t <= 1 {
+			ps[n] = s.A.Add(d.Scale(t))
+			n++
+		}
+	}
+	return ps[0], ps[1], n
+}
+
+// circleLineParams solves |p + t*d - c.Center| = c.Radius for t and returns
+// the solutions in increasing order along with their count.
+func circleLineParams(c Circle, p, d Vec2) (t1, t2 float64, n int) {
+	a := Dot2(d, d)
+	if a == 0 {
+		return 0, 0, 0
+	}
+	f := p.Sub(c.Center)
+	b := Dot2(f, d)
+	disc := b*b - a*(Dot2(f, f)-c.Radius*c.Radius)
+	switch {
+	case disc < 0:
+		return 0, 0, 0
+	case disc == 0:
+		return -b / a, 0, 1
+	}
+	sq := math.Sqrt(disc)
+	return (-b - sq) / a, (-b + sq) / a, 2
+}
+
+// IntersectionKind classifies how two lines, rays or segments meet.
+type IntersectionKind int
+
+const (
+	// Disjoint means the two are not parallel, but their lines cross
+	// outside the extent of at least one of them.
+	Disjoint IntersectionKind = iota
+	// Crossing means the two meet in exactly one point.
+	Crossing
+	// Parallel means the two are parallel and lie on different lines.
+	Parallel
+	// Collinear means the two lie on the same line but do not overlap.
+	Collinear
+	// Overlapping means the two lie on the same line and share at least one point.
+	Overlapping
+)
+
+var intersectionKindNames = [...]string{"Disjoint", "Crossing", "Parallel", "Collinear", "Overlapping"}
+
+// String returns the name of k, such as "Crossing".
+func (k IntersectionKind) String() string {
+	if k < 0 || int(k) >= len(intersectionKindNames) {
+		return fmt.Sprintf("IntersectionKind(%d)", int(k))
+	}
+	return intersectionKindNames[k]
+}
+
+// The functions below report the meeting point p of a and b along with its
+// parameters t and u, such that p is the point of a at t and of b at u: a
+// segment runs from A at 0 to B at 1, and lines and rays are at 0 at their
+// start and at 1 one Dir further. For Disjoint, p is where the lines
+// through a and b cross. For Overlapping, p is the shared point nearest to
+// the start of a. For Parallel and Collinear, p, t and u are zero.
+
+// IntersectLines2 returns where lines a and b meet.
+// The kind is Crossing, Parallel, or Overlapping if the lines coincide.
+func IntersectLines2(a, b Line2) (p Vec2, t, u float64, kind IntersectionKind) {
+	inf := math.Inf(1)
+	return intersectLinear(a.Point, a.Dir, -inf, inf, b.Point, b.Dir, -inf, inf)
+}
+
+// IntersectSegments2 returns where segments a and b meet.
+func IntersectSegments2(a, b Segment2) (p Vec2, t, u float64, kind IntersectionKind) {
+	return intersectLinear(a.A, a.B.Sub(a.A), 0, 1, b.A, b.B.Sub(b.A), 0, 1)
+}
+
+// IntersectRaySegment2 returns where ray r meets segment s, with t the
+// parameter along r and u along s. For Overlapping, p is the first point of
+// s that r reaches.
+func IntersectRaySegment2(r Ray2, s Segment2) (p Vec2, t, u float64, kind IntersectionKind) {
+	return intersectLinear(r.Origin, r.Dir, 0, math.Inf(1), s.A, s.B.Sub(s.A), 0, 1)
+}
+
+// intersectLinear intersects the point sets p1 + t*d1 for t in [lo1, hi1]
+// and p2 + u*d2 for u in [lo2, hi2].
+func intersectLinear(p1, d1 Vec2, lo1, hi1 float64, p2, d2 Vec2, lo2, hi2 float64) (Vec2, float64, float64, IntersectionKind) {
+	r := p2.Sub(p1)
+	denom := Cross2(d1, d2)
+	l1, l2 := Len2(d1), Len2(d2)
+	if math.Abs(denom) > 1e-12*l1*l2 {
+		t, u := Cross2(r, d2)/denom, Cross2(r, d1)/denom
+		kind := Disjoint
+		if t >= lo1 && t <= hi1 && u >= lo2 && u <= hi2 {
+			kind = Crossing
+		}
+		return p1.Add(d1.Scale(t)), t, u, kind
+	}
+	if math.Abs(Cross2(r, d1)) > 1e-12*l1*Len2(r) {
+		return Vec2{}, 0, 0, Parallel
+	}
+
+	// On the same line: find b's extent in a's parameter and overlap them.
+	dd := Dot2(d1, d1)
+	t0, dt := Dot2(r, d1)/dd, Dot2(d2, d1)/dd // b at u is a at t0 + u*dt
+	blo, bhi := t0+lo2*dt, t0+hi2*dt
+	if blo > bhi {
+		blo, bhi = bhi, blo
+	}
+	lo, hi := math.Max(lo1, blo), math.Min(hi1, bhi)
+	if lo > hi {
+		return Vec2{}, 0, 0, Collinear
+	}
+	t := min(max(0, lo), hi)
+	return p1.Add(d1.Scale(t)), t, (t - t0) / dt, Overlapping
+}
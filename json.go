@@ -0,0 +1,130 @@
+package vec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONForm selects how vectors are encoded as JSON.
+type JSONForm int
+
+const (
+	JSONArray  JSONForm = iota // [1,2,3]
+	JSONObject                 // {"x":1,"y":2,"z":3}
+)
+
+// JSONEncoding is the form used by MarshalJSON on all vector types.
+// UnmarshalJSON accepts either form regardless of this setting.
+var JSONEncoding = JSONArray
+
+type (
+	jsonObject2[S Scalar] struct {
+		X S `json:"x"`
+		Y S `json:"y"`
+	}
+	jsonObject3[S Scalar] struct {
+		X S `json:"x"`
+		Y S `json:"y"`
+		Z S `json:"z"`
+	}
+	jsonObject4[S Scalar] struct {
+		X S `json:"x"`
+		Y S `json:"y"`
+		Z S `json:"z"`
+		W S `json:"w"`
+	}
+)
+
+// MarshalJSON implements json.Marshaler in the form selected by JSONEncoding.
+func (a Vec2g[S]) MarshalJSON() ([]byte, error) {
+	if JSONEncoding == JSONObject {
+		return json.Marshal(jsonObject2[S](a))
+	}
+	return json.Marshal([2]S{a.X, a.Y})
+}
+
+// MarshalJSON implements json.Marshaler in the form selected by JSONEncoding.
+func (a Vec3g[S]) MarshalJSON() ([]byte, error) {
+	if JSONEncoding == JSONObject {
+		return json.Marshal(jsonObject3[S](a))
+	}
+	return json.Marshal([3]S{a.X, a.Y, a.Z})
+}
+
+// MarshalJSON implements json.Marshaler in the form selected by JSONEncoding.
+func (a Vec4g[S]) MarshalJSON() ([]byte, error) {
+	if JSONEncoding == JSONObject {
+		return json.Marshal(jsonObject4[S](a))
+	}
+	return json.Marshal([4]S{a.X, a.Y, a.Z, a.W})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either an array or an object.
+// Object keys are matched case-insensitively, and missing keys are left zero.
+// As with the standard types, null leaves a unchanged.
+func (a *Vec2g[S]) UnmarshalJSON(data []byte) error {
+	var obj jsonObject2[S]
+	c, null, err := unmarshalJSONVec[S](data, &obj, 2)
+	if err != nil || null {
+		return err
+	}
+	if c != nil {
+		obj = jsonObject2[S]{c[0], c[1]}
+	}
+	*a = Vec2g[S](obj)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either an array or an object.
+// Object keys are matched case-insensitively, and missing keys are left zero.
+// As with the standard types, null leaves a unchanged.
+func (a *Vec3g[S]) UnmarshalJSON(data []byte) error {
+	var obj jsonObject3[S]
+	c, null, err := unmarshalJSONVec[S](data, &obj, 3)
+	if err != nil || null {
+		return err
+	}
+	if c != nil {
+		obj = jsonObject3[S]{c[0], c[1], c[2]}
+	}
+	*a = Vec3g[S](obj)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either an array or an object.
+// Object keys are matched case-insensitively, and missing keys are left zero.
+// As with the standard types, null leaves a unchanged.
+func (a *Vec4g[S]) UnmarshalJSON(data []byte) error {
+	var obj jsonObject4[S]
+	c, null, err := unmarshalJSONVec[S](data, &obj, 4)
+	if err != nil || null {
+		return err
+	}
+	if c != nil {
+		obj = jsonObject4[S]{c[0], c[1], c[2], c[3]}
+	}
+	*a = Vec4g[S](obj)
+	return nil
+}
+
+// unmarshalJSONVec decodes data as either an array of exactly n components,
+// which it returns, or an object, which it decodes into obj. It reports
+// null without decoding anything, so that the caller can leave its value
+// unchanged.
+func unmarshalJSONVec[S Scalar](data []byte, obj any, n int) (c []S, null bool, err error) {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) {
+		return nil, true, nil
+	}
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return nil, false, json.Unmarshal(data, obj)
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, false, err
+	}
+	if len(c) != n {
+		return nil, false, fmt.Errorf("vec: expected %d components in JSON array, got %d", n, len(c))
+	}
+	return c, false, nil
+}
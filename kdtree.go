@@ -0,0 +1,135 @@
+package vec
+
+import (
+	"math"
+	"slices"
+)
+
+// KDTree2 is a static k-d tree over 2D points for nearest-neighbor queries.
+type KDTree2 struct{ t kdTree[Vec2] }
+
+// KDTree3 is a static k-d tree over 3D points for nearest-neighbor queries.
+type KDTree3 struct{ t kdTree[Vec3] }
+
+// NewKDTree2 builds a k-d tree over points in O(n log² n) time.
+// The tree refers to points, which must not be modified while it is in use.
+func NewKDTree2(points []Vec2) *KDTree2 {
+	return &KDTree2{newKDTree(points, 2, func(p Vec2, axis int) float64 { return ToArray2(p)[axis] })}
+}
+
+// NewKDTree3 builds a k-d tree over points in O(n log² n) time.
+// The tree refers to points, which must not be modified while it is in use.
+func NewKDTree3(points []Vec3) *KDTree3 {
+	return &KDTree3{newKDTree(points, 3, func(p Vec3, axis int) float64 { return ToArray3(p)[axis] })}
+}
+
+// Nearest returns the index of the point closest to p and its squared distance.
+// Returns -1 if the tree is empty.
+func (t *KDTree2) Nearest(p Vec2) (i int, distSq float64) {
+	return t.t.nearest(p, func(a, b Vec2) float64 { return LenSq2(a.Sub(b)) })
+}
+
+// Nearest returns the index of the point closest to p and its squared distance.
+// Returns -1 if the tree is empty.
+func (t *KDTree3) Nearest(p Vec3) (i int, distSq float64) {
+	return t.t.nearest(p, func(a, b Vec3) float64 { return LenSq3(a.Sub(b)) })
+}
+
+// InRadius returns the indices of all points within distance r of p, in no particular order.
+func (t *KDTree2) InRadius(p Vec2, r float64) []int {
+	return t.t.inRadius(p, r, func(a, b Vec2) float64 { return LenSq2(a.Sub(b)) })
+}
+
+// InRadius returns the indices of all points within distance r of p, in no particular order.
+func (t *KDTree3) InRadius(p Vec3, r float64) []int {
+	return t.t.inRadius(p, r, func(a, b Vec3) float64 { return LenSq3(a.Sub(b)) })
+}
+
+// kdTree stores point indices as an implicit balanced tree: the median of
+// each range of order is the node, splitting on axis depth%dims.
+type kdTree[V any] struct {
+	points []V
+	order  []int
+	dims   int
+	coord  func(V, int) float64
+}
+
+func newKDTree[V any](points []V, dims int, coord func(V, int) float64) kdTree[V] {
+	t := kdTree[V]{points, make([]int, len(points)), dims, coord}
+	for i := range t.order {
+		t.order[i] = i
+	}
+	t.build(t.order, 0)
+	return t
+}
+
+func (t *kdTree[V]) build(order []int, depth int) {
+	if len(order) <= 1 {
+		return
+	}
+	axis := depth % t.dims
+	slices.SortFunc(order, func(a, b int) int {
+		ca, cb := t.coord(t.points[a], axis), t.coord(t.points[b], axis)
+		switch {
+		case ca < cb:
+			return -1
+		case ca > cb:
+			return 1
+		}
+		return 0
+	})
+	mid := len(order) / 2
+	t.build(order[:mid], depth+1)
+	t.build(order[mid+1:], depth+1)
+}
+
+func (t *kdTree[V]) nearest(p V, distSq func(a, b V) float64) (int, float64) {
+	best, bestD := -1, math.Inf(1)
+	var search func(order []int, depth int)
+	search = func(order []int, depth int) {
+		if len(order) == 0 {
+			return
+		}
+		mid := len(order) / 2
+		i := order[mid]
+		if d := distSq(p, t.points[i]); d < bestD {
+			best, bestD = i, d
+		}
+		axis := depth % t.dims
+		diff := t.coord(p, axis) - t.coord(t.points[i], axis)
+		near, far := order[:mid], order[mid+1:]
+		if diff > 0 {
+			near, far = far, near
+		}
+		search(near, depth+1)
+		if diff*diff < bestD {
+			search(far, depth+1)
+		}
+	}
+	search(t.order, 0)
+	return best, bestD
+}
+
+func (t *kdTree[V]) inRadius(p V, r float64, distSq func(a, b V) float64) []int {
+	var found []int
+	var search func(order []int, depth int)
+	search = func(order []int, depth int) {
+		if len(order) == 0 {
+			return
+		}
+		mid := len(order) / 2
+		i := order[mid]
+		if distSq(p, t.points[i]) <= r*r {
+			found = append(found, i)
+		}
+		diff := t.coord(p, depth%t.dims) - t.coord(t.points[i], depth%t.dims)
+		if diff <= r {
+			search(order[:mid], depth+1)
+		}
+		if diff >= -r {
+			search(order[mid+1:], depth+1)
+		}
+	}
+	search(t.order, 0)
+	return found
+}
@@ -0,0 +1,103 @@
+package vec
+
+import "math"
+
+// The methods below lay out rectangles for user interfaces and HUDs.
+// Relative positions are fractions of the rectangle's size from Min, so
+// (0, 0) is the Min corner, (0.5, 0.5) the center and (1, 1) the Max
+// corner. For integer rectangles, computed edges are rounded to the
+// nearest integer.
+
+// Insets are distances inward from each side of a rectangle. With Y
+// pointing down, as on screens, Top is the Min.Y side.
+type Insets[S Scalar] struct{ Left, Top, Right, Bottom S }
+
+// UniformInsets returns insets of s on every side.
+func UniformInsets[S Scalar](s S) Insets[S] { return Insets[S]{s, s, s, s} }
+
+// Center returns the center of r.
+func (r Rectg[S]) Center() Vec2 { return r.Anchor(Vec2{0.5, 0.5}) }
+
+// Anchor returns the point at the relative position rel within r.
+func (r Rectg[S]) Anchor(rel Vec2) Vec2 {
+	return As2[float64](r.Min).Add(As2[float64](r.Size()).Mul(rel))
+}
+
+// AlignTo returns r moved so that its point at the relative position align
+// coincides with the same point of other. An align of (0.5, 0.5) centers r
+// on other, and (1, 0) puts it in other's Max.X, Min.Y corner.
+func (r Rectg[S]) AlignTo(other Rectg[S], align Vec2) Rectg[S] {
+	size := r.Size()
+	min := other.Anchor(align).Sub(As2[float64](size).Mul(align))
+	m := Vec2g[S]{layoutRound[S](min.X), layoutRound[S](min.Y)}
+	return Rectg[S]{m, m.Add(size)}
+}
+
+// SplitH splits r into side-by-side columns whose widths are proportional
+// to weights, from Min.X to Max.X. The columns tile r exactly.
+func (r Rectg[S]) SplitH(weights ...float64) []Rectg[S] {
+	rs := make([]Rectg[S], len(weights))
+	for i, e := range splitEdges(r.Min.X, r.Max.X, weights) {
+		rs[i] = Rectg[S]{Vec2g[S]{e[0], r.Min.Y}, Vec2g[S]{e[1], r.Max.Y}}
+	}
+	return rs
+}
+
+// SplitV splits r into stacked rows whose heights are proportional to
+// weights, from Min.Y to Max.Y. The rows tile r exactly.
+func (r Rectg[S]) SplitV(weights ...float64) []Rectg[S] {
+	rs := make([]Rectg[S], len(weights))
+	for i, e := range splitEdges(r.Min.Y, r.Max.Y, weights) {
+		rs[i] = Rectg[S]{Vec2g[S]{r.Min.X, e[0]}, Vec2g[S]{r.Max.X, e[1]}}
+	}
+	return rs
+}
+
+// Pad returns r shrunk by in on each side. Negative insets grow r.
+func (r Rectg[S]) Pad(in Insets[S]) Rectg[S] {
+	return Rectg[S]{
+		Vec2g[S]{r.Min.X + in.Left, r.Min.Y + in.Top},
+		Vec2g[S]{r.Max.X - in.Right, r.Max.Y - in.Bottom},
+	}
+}
+
+// FitAspect returns the largest rectangle centered in r with the same
+// aspect ratio as the size target, leaving bars on two sides of r as
+// needed, as for letterboxing a fixed-ratio game screen in a window.
+func (r Rectg[S]) FitAspect(target Vec2g[S]) Rectg[S] {
+	size, t := As2[float64](r.Size()), As2[float64](target)
+	scale := math.Min(size.X/t.X, size.Y/t.Y)
+	fit := t.Muls(scale)
+	fs := Vec2g[S]{layoutRound[S](fit.X), layoutRound[S](fit.Y)}
+	return Rectg[S]{Max: fs}.AlignTo(r, Vec2{0.5, 0.5})
+}
+
+// splitEdges returns the edges of the parts of [lo, hi] proportional to
+// weights.
+func splitEdges[S Scalar](lo, hi S, weights []float64) [][2]S {
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	edges := make([][2]S, len(weights))
+	var acc float64
+	prev := lo
+	for i, w := range weights {
+		acc += w
+		next := hi
+		if i < len(weights)-1 && total > 0 {
+			next = layoutRound[S](float64(lo) + float64(hi-lo)*acc/total)
+		}
+		edges[i] = [2]S{prev, next}
+		prev = next
+	}
+	return edges
+}
+
+// layoutRound converts x to S, rounding to the nearest integer for integer types.
+func layoutRound[S Scalar](x float64) S {
+	if isFloat[S]() {
+		return S(x)
+	}
+	return S(math.Round(x))
+}
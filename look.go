@@ -0,0 +1,58 @@
+package vec
+
+// LookRotationMat3 returns the rotation that turns -Z toward forward and
+// +Y as close to up as possible, following the OpenGL convention that
+// cameras and models look down -Z. It is the orientation of a camera whose
+// view matrix is LookAt(eye, eye.Add(forward), up). If forward is parallel
+// to up, an arbitrary perpendicular up is used.
+func LookRotationMat3(forward, up Vec3) Mat3 {
+	f := Normalize3(forward)
+	u := perpendicularTo(up, f)
+	if u == (Vec3{}) {
+		u = anyPerpendicular3(f)
+	}
+	return basisMat3(Cross3(f, u), u, f.Neg())
+}
+
+// LookRotation returns LookRotationMat3(forward, up) as a quaternion.
+func LookRotation(forward, up Vec3) Quat { return QuatFromMat3(LookRotationMat3(forward, up)) }
+
+// BillboardSpherical returns the model matrix of a sprite at pos that
+// faces the camera at cameraPos from any angle. The sprite's quad lies in
+// its local XY plane with +Z toward the camera and +Y as close to up as
+// possible.
+func BillboardSpherical(pos, cameraPos, up Vec3) Mat4 {
+	return rigidMat4(LookRotationMat3(pos.Sub(cameraPos), up), pos)
+}
+
+// BillboardCylindrical returns the model matrix of a sprite at pos that
+// turns only about axis to face the camera at cameraPos, as for trees and
+// characters that must stay upright. The sprite's local +Y is along axis
+// and +Z as close to the camera as possible.
+func BillboardCylindrical(pos, cameraPos, axis Vec3) Mat4 {
+	y := Normalize3(axis)
+	z := perpendicularTo(cameraPos.Sub(pos), y)
+	if z == (Vec3{}) {
+		z = anyPerpendicular3(y)
+	}
+	return rigidMat4(basisMat3(Cross3(y, z), y, z), pos)
+}
+
+// basisMat3 returns the matrix whose columns are x, y and z.
+func basisMat3(x, y, z Vec3) Mat3 {
+	return Mat3{
+		{x.X, y.X, z.X},
+		{x.Y, y.Y, z.Y},
+		{x.Z, y.Z, z.Z},
+	}
+}
+
+// rigidMat4 returns the homogeneous transform rotating by r, then moving by t.
+func rigidMat4(r Mat3, t Vec3) Mat4 {
+	return Mat4{
+		{r[0][0], r[0][1], r[0][2], t.X},
+		{r[1][0], r[1][1], r[1][2], t.Y},
+		{r[2][0], r[2][1], r[2][2], t.Z},
+		{0, 0, 0, 1},
+	}
+}
@@ -0,0 +1,262 @@
+package vec
+
+// MarchingCubes extracts the iso-surface where the sampled field crosses iso
+// as a triangle mesh. Each grid cell holds a sample, and adjacent samples
+// form the corners of the cubes marched over, so positions are in grid
+// coordinates: sample p lies at As3[float64](p).
+//
+// Samples greater than iso are inside. Triangles are wound counter-clockwise
+// as seen from outside, so their normals point toward lower values.
+// Ambiguous cube faces are resolved by separating the inside corners, which
+// keeps the surface watertight between neighboring cubes. Vertices on shared
+// cube edges are shared between triangles.
+func MarchingCubes(g *Grid3[float64], iso float64) Mesh {
+	var m Mesh
+	vertices := make(map[int]int) // grid edge id -> vertex index
+
+	// vertex returns the index of the vertex on cube edge e of the cube at p.
+	vertex := func(p Vec3i, e int) int {
+		a, b := mcEdges[e][0], mcEdges[e][1]
+		pa, pb := p.Add(mcCorner(a)), p.Add(mcCorner(b))
+		axis := 0
+		for bit := a ^ b; bit > 1; bit >>= 1 {
+			axis++
+		}
+		id := 3*g.Index(pa) + axis
+		if i, ok := vertices[id]; ok {
+			return i
+		}
+		va, vb := g.At(pa), g.At(pb)
+		t := (iso - va) / (vb - va)
+		fa, fb := As3[float64](pa), As3[float64](pb)
+		i := len(m.Positions)
+		m.Positions = append(m.Positions, Lerp3(fa, fb, t))
+		vertices[id] = i
+		return i
+	}
+
+	for z := 0; z < g.Size.Z-1; z++ {
+		for y := 0; y < g.Size.Y-1; y++ {
+			for x := 0; x < g.Size.X-1; x++ {
+				p := Vec3i{x, y, z}
+				config := 0
+				for c := range 8 {
+					if g.At(p.Add(mcCorner(c))) > iso {
+						config |= 1 << c
+					}
+				}
+				for _, e := range mcTriangles[config] {
+					m.Indices = append(m.Indices, vertex(p, e))
+				}
+			}
+		}
+	}
+	return m
+}
+
+// Cube corner c sits at offset (c&1, c>>1&1, c>>2&1), and the 12 cube edges
+// join corners that differ in a single bit.
+var (
+	mcEdges     = marchingCubesEdges()
+	mcTriangles = marchingCubesTriangles()
+)
+
+func mcCorner(c int) Vec3i { return Vec3i{c & 1, c >> 1 & 1, c >> 2 & 1} }
+
+func marchingCubesEdges() [12][2]int {
+	var edges [12][2]int
+	n := 0
+	for a := range 8 {
+		for bit := 1; bit < 8; bit <<= 1 {
+			if a&bit == 0 {
+				edges[n] = [2]int{a, a | bit}
+				n++
+			}
+		}
+	}
+	return edges
+}
+
+// marchingCubesTriangles builds the triangle table, listing the cube edges
+// of each triangle for every corner configuration. Rather than hard-coding
+// the classic table, it traces the surface boundary around the cube faces:
+// walking each face's corners counter-clockwise as seen from outside, every
+// crossing from an outside corner to an inside one is joined to the next
+// crossing. The joined segments form closed loops, which are fanned into
+// triangles with the winding described in MarchingCubes.
+func marchingCubesTriangles() [256][]int {
+	edgeOf := func(a, b int) int {
+		for i, e := range mcEdges {
+			if e == [2]int{min(a, b), max(a, b)} {
+				return i
+			}
+		}
+		panic("unreachable")
+	}
+
+	// Corner cycles of the six faces, counter-clockwise from outside.
+	var faces [][4]int
+	for k := range 3 {
+		u, v := 1<<((k+1)%3), 1<<((k+2)%3)
+		for s := range 2 {
+			base := s << k
+			cycle := [4]int{base, base | u, base | u | v, base | v}
+			if s == 0 {
+				cycle[1], cycle[3] = cycle[3], cycle[1]
+			}
+			faces = append(faces, cycle)
+		}
+	}
+
+	var table [256][]int
+	for config := range 256 {
+		inside := func(c int) bool { return config&(1<<c) != 0 }
+		next := make(map[int]int)
+		for _, cycle := range faces {
+			type crossing struct {
+				edge  int
+				enter bool
+			}
+			var xs []crossing
+			for i := range 4 {
+				a, b := cycle[i], cycle[(i+1)%4]
+				if inside(a) != inside(b) {
+					xs = append(xs, crossing{edgeOf(a, b), inside(b)})
+				}
+			}
+			for i, x := range xs {
+				if x.enter {
+					next[x.edge] = xs[(i+1)%len(xs)].edge
+				}
+			}
+		}
+
+		visited := make(map[int]bool)
+		for e := range 12 {
+			if _, ok := next[e]; !ok || visited[e] {
+				continue
+			}
+			var loop []int
+			for cur := e; !visited[cur]; cur = next[cur] {
+				visited[cur] = true
+				loop = append(loop, cur)
+			}
+			for i := 1; i+1 < len(loop); i++ {
+				table[config] = append(table[config], loop[0], loop[i], loop[i+1])
+			}
+		}
+	}
+	return table
+}
+
+// MarchingSquares extracts the contours where field crosses threshold as
+// polylines. The field is sampled at every integer point in bounds, with
+// Max exclusive, and adjacent samples form the corners of the squares
+// marched over.
+//
+// Samples greater than threshold are inside. Contours run counter-clockwise
+// around inside regions, keeping the inside on their left. Contours that
+// close are returned as closed paths; the rest end on the edge of bounds.
+// Ambiguous squares are resolved by the mean of their corners.
+func MarchingSquares(field func(Vec2i) float64, threshold float64, bounds Recti) []Path2 {
+	size := bounds.Size()
+	if size.X < 2 || size.Y < 2 {
+		return nil
+	}
+	g := NewGrid[float64](size)
+	for p := range g.All() {
+		g.Set(p, field(p.Add(bounds.Min)))
+	}
+
+	// A crossing is identified by the sample it starts from and the axis of
+	// the grid edge it lies on.
+	type crossing struct {
+		p    Vec2i
+		axis int
+	}
+	next := make(map[crossing]crossing)
+	var starts []crossing
+	hasPrev := make(map[crossing]bool)
+	for y := 0; y < size.Y-1; y++ {
+		for x := 0; x < size.X-1; x++ {
+			p := Vec2i{x, y}
+			var corner [4]Vec2i
+			var in [4]bool
+			var sum float64
+			for i := range 4 {
+				corner[i] = p.Add(msCorners[i])
+				v := g.At(corner[i])
+				in[i] = v > threshold
+				sum += v
+			}
+			// Walking the square's edges counter-clockwise, an exit goes from
+			// an inside corner to an outside one. Each exit is joined to the
+			// following entry, or to the preceding one when the insides of an
+			// ambiguous square are separated.
+			step := 3
+			if sum/4 > threshold {
+				step = 1
+			}
+			edge := func(i int) crossing {
+				a, b := corner[i], corner[(i+1)%4]
+				if a.X == b.X {
+					return crossing{Vec2i{a.X, min(a.Y, b.Y)}, 1}
+				}
+				return crossing{Vec2i{min(a.X, b.X), a.Y}, 0}
+			}
+			for i := range 4 {
+				if !in[i] || in[(i+1)%4] {
+					continue
+				}
+				j := (i + step) % 4
+				for in[j] || !in[(j+1)%4] {
+					j = (j + step) % 4
+				}
+				from, to := edge(i), edge(j)
+				next[from] = to
+				hasPrev[to] = true
+				starts = append(starts, from)
+			}
+		}
+	}
+
+	point := func(c crossing) Vec2 {
+		a, b := c.p, c.p.Add(Vec2i{1 - c.axis, c.axis})
+		va, vb := g.At(a), g.At(b)
+		t := (threshold - va) / (vb - va)
+		return Lerp2(As2[float64](a.Add(bounds.Min)), As2[float64](b.Add(bounds.Min)), t)
+	}
+	var paths []Path2
+	trace := func(c crossing) {
+		path := Path2{point(c)}
+		start := c
+		for {
+			n, ok := next[c]
+			if !ok {
+				break
+			}
+			delete(next, c)
+			path = append(path, point(n))
+			c = n
+			if c == start {
+				break
+			}
+		}
+		paths = append(paths, path)
+	}
+	// Open contours first, from their ends on the boundary, then loops.
+	for _, c := range starts {
+		if _, ok := next[c]; ok && !hasPrev[c] {
+			trace(c)
+		}
+	}
+	for _, c := range starts {
+		if _, ok := next[c]; ok {
+			trace(c)
+		}
+	}
+	return paths
+}
+
+// msCorners lists the corners of a square counter-clockwise from its minimum.
+var msCorners = [4]Vec2i{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
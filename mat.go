@@ -0,0 +1,214 @@
+package vec
+
+// Mat2 is a 2x2 matrix of float64 in row-major order: m[i][j] is row i, column j.
+type Mat2 [2][2]float64
+
+// Mat3 is a 3x3 matrix of float64 in row-major order: m[i][j] is row i, column j.
+type Mat3 [3][3]float64
+
+// Mat4 is a 4x4 matrix of float64 in row-major order: m[i][j] is row i, column j.
+type Mat4 [4][4]float64
+
+// Identity2 returns the 2x2 identity matrix.
+func Identity2() Mat2 { return Mat2{{1, 0}, {0, 1}} }
+
+// Identity3 returns the 3x3 identity matrix.
+func Identity3() Mat3 { return Mat3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}} }
+
+// Identity4 returns the 4x4 identity matrix.
+func Identity4() Mat4 { return Mat4{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}} }
+
+// Mat2
+// ---
+
+// Mul returns the matrix product m*n.
+func (m Mat2) Mul(n Mat2) Mat2 {
+	var r Mat2
+	for i := range 2 {
+		for j := range 2 {
+			r[i][j] = m[i][0]*n[0][j] + m[i][1]*n[1][j]
+		}
+	}
+	return r
+}
+
+// MulVec returns the product m*v, treating v as a column vector.
+func (m Mat2) MulVec(v Vec2) Vec2 {
+	return Vec2{
+		m[0][0]*v.X + m[0][1]*v.Y,
+		m[1][0]*v.X + m[1][1]*v.Y,
+	}
+}
+
+// Transpose returns the transpose of m.
+func (m Mat2) Transpose() Mat2 { return Mat2{{m[0][0], m[1][0]}, {m[0][1], m[1][1]}} }
+
+// Det returns the determinant of m.
+func (m Mat2) Det() float64 { return m[0][0]*m[1][1] - m[0][1]*m[1][0] }
+
+// Inverse returns the inverse of m.
+// Returns false if m is singular.
+func (m Mat2) Inverse() (Mat2, bool) {
+	d := m.Det()
+	if d == 0 {
+		return Mat2{}, false
+	}
+	return Mat2{
+		{m[1][1] / d, -m[0][1] / d},
+		{-m[1][0] / d, m[0][0] / d},
+	}, true
+}
+
+// Mat3
+// ---
+
+// Mul returns the matrix product m*n.
+func (m Mat3) Mul(n Mat3) Mat3 {
+	var r Mat3
+	for i := range 3 {
+		for j := range 3 {
+			r[i][j] = m[i][0]*n[0][j] + m[i][1]*n[1][j] + m[i][2]*n[2][j]
+		}
+	}
+	return r
+}
+
+// MulVec returns the product m*v, treating v as a column vector.
+func (m Mat3) MulVec(v Vec3) Vec3 {
+	return Vec3{
+		m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z,
+		m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z,
+		m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+// Transpose returns the transpose of m.
+func (m Mat3) Transpose() Mat3 {
+	return Mat3{
+		{m[0][0], m[1][0], m[2][0]},
+		{m[0][1], m[1][1], m[2][1]},
+		{m[0][2], m[1][2], m[2][2]},
+	}
+}
+
+// Det returns the determinant of m.
+func (m Mat3) Det() float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// Inverse returns the inverse of m.
+// Returns false if m is singular.
+func (m Mat3) Inverse() (Mat3, bool) {
+	d := m.Det()
+	if d == 0 {
+		return Mat3{}, false
+	}
+	return Mat3{
+		{
+			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / d,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) / d,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) / d,
+		},
+		{
+			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / d,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) / d,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) / d,
+		},
+		{
+			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / d,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) / d,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) / d,
+		},
+	}, true
+}
+
+// Mat4
+// ---
+
+// Mul returns the matrix product m*n.
+func (m Mat4) Mul(n Mat4) Mat4 {
+	var r Mat4
+	for i := range 4 {
+		for j := range 4 {
+			r[i][j] = m[i][0]*n[0][j] + m[i][1]*n[1][j] + m[i][2]*n[2][j] + m[i][3]*n[3][j]
+		}
+	}
+	return r
+}
+
+// MulVec returns the product m*v, treating v as a column vector.
+func (m Mat4) MulVec(v Vec4) Vec4 {
+	return Vec4{
+		m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z + m[0][3]*v.W,
+		m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z + m[1][3]*v.W,
+		m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z + m[2][3]*v.W,
+		m[3][0]*v.X + m[3][1]*v.Y + m[3][2]*v.Z + m[3][3]*v.W,
+	}
+}
+
+// Transpose returns the transpose of m.
+func (m Mat4) Transpose() Mat4 {
+	var r Mat4
+	for i := range 4 {
+		for j := range 4 {
+			r[i][j] = m[j][i]
+		}
+	}
+	return r
+}
+
+// Det returns the determinant of m.
+func (m Mat4) Det() float64 {
+	s, c := m.subDets()
+	return s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+}
+
+// Inverse returns the inverse of m.
+// Returns false if m is singular.
+func (m Mat4) Inverse() (Mat4, bool) {
+	s, c := m.subDets()
+	d := s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+	if d == 0 {
+		return Mat4{}, false
+	}
+	return Mat4{
+		{
+			(m[1][1]*c[5] - m[1][2]*c[4] + m[1][3]*c[3]) / d,
+			(-m[0][1]*c[5] + m[0][2]*c[4] - m[0][3]*c[3]) / d,
+			(m[3][1]*s[5] - m[3][2]*s[4] + m[3][3]*s[3]) / d,
+			(-m[2][1]*s[5] + m[2][2]*s[4] - m[2][3]*s[3]) / d,
+		},
+		{
+			(-m[1][0]*c[5] + m[1][2]*c[2] - m[1][3]*c[1]) / d,
+			(m[0][0]*c[5] - m[0][2]*c[2] + m[0][3]*c[1]) / d,
+			(-m[3][0]*s[5] + m[3][2]*s[2] - m[3][3]*s[1]) / d,
+			(m[2][0]*s[5] - m[2][2]*s[2] + m[2][3]*s[1]) / d,
+		},
+		{
+			(m[1][0]*c[4] - m[1][1]*c[2] + m[1][3]*c[0]) / d,
+			(-m[0][0]*c[4] + m[0][1]*c[2] - m[0][3]*c[0]) / d,
+			(m[3][0]*s[4] - m[3][1]*s[2] + m[3][3]*s[0]) / d,
+			(-m[2][0]*s[4] + m[2][1]*s[2] - m[2][3]*s[0]) / d,
+		},
+		{
+			(-m[1][0]*c[3] + m[1][1]*c[1] - m[1][2]*c[0]) / d,
+			(m[0][0]*c[3] - m[0][1]*c[1] + m[0][2]*c[0]) / d,
+			(-m[3][0]*s[3] + m[3][1]*s[1] - m[3][2]*s[0]) / d,
+			(m[2][0]*s[3] - m[2][1]*s[1] + m[2][2]*s[0]) / d,
+		},
+	}, true
+}
+
+// subDets returns the 2x2 determinants of the top two rows (s) and the
+// bottom two rows (c) of m, for the column pairs 01, 02, 03, 12, 13, 23.
+func (m Mat4) subDets() (s, c [6]float64) {
+	pairs := [6][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}
+	for k, p := range pairs {
+		i, j := p[0], p[1]
+		s[k] = m[0][i]*m[1][j] - m[0][j]*m[1][i]
+		c[k] = m[2][i]*m[3][j] - m[2][j]*m[3][i]
+	}
+	return s, c
+}
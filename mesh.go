@@ -0,0 +1,105 @@
+package vec
+
+// Mesh is an indexed triangle mesh.
+// Each consecutive triple of Indices refers to the Positions of one triangle.
+type Mesh struct {
+	Positions []Vec3
+	Indices   []int
+}
+
+// NumTriangles returns the number of triangles in m.
+func (m Mesh) NumTriangles() int { return len(m.Indices) / 3 }
+
+// Triangle returns the vertex positions of the i-th triangle of m.
+func (m Mesh) Triangle(i int) (a, b, c Vec3) {
+	return m.Positions[m.Indices[3*i]], m.Positions[m.Indices[3*i+1]], m.Positions[m.Indices[3*i+2]]
+}
+
+// ComputeTangents returns a tangent for each vertex of the indexed triangle
+// list, for normal mapping. The tangent XYZ points along increasing U of
+// the texture coordinates uvs and is orthogonal to the smooth vertex normal;
+// W is the handedness, ±1, such that the bitangent along increasing V is
+// W * Cross3(normal, tangent). Triangles with degenerate texture
+// coordinates are skipped. It panics if uvs and positions differ in length.
+func ComputeTangents(positions []Vec3, uvs []Vec2, indices []int) []Vec4 {
+	if len(uvs) != len(positions) {
+		panic("vec: ComputeTangents length mismatch")
+	}
+	tan := make([]Vec3, len(positions))
+	bitan := make([]Vec3, len(positions))
+	for i := 0; i+2 < len(indices); i += 3 {
+		i0, i1, i2 := indices[i], indices[i+1], indices[i+2]
+		e1, e2 := positions[i1].Sub(positions[i0]), positions[i2].Sub(positions[i0])
+		d1, d2 := uvs[i1].Sub(uvs[i0]), uvs[i2].Sub(uvs[i0])
+		det := Cross2(d1, d2)
+		if det == 0 {
+			continue
+		}
+		t := e1.Muls(d2.Y).Sub(e2.Muls(d1.Y)).Divs(det)
+		b := e2.Muls(d1.X).Sub(e1.Muls(d2.X)).Divs(det)
+		for _, j := range [3]int{i0, i1, i2} {
+			tan[j] = tan[j].Add(t)
+			bitan[j] = bitan[j].Add(b)
+		}
+	}
+
+	normals := ComputeNormals(positions, indices)
+	out := make([]Vec4, len(positions))
+	for i, n := range normals {
+		t := perpendicularTo(tan[i], n)
+		if t == (Vec3{}) {
+			t = anyPerpendicular3(n)
+		}
+		w := 1.0
+		if Dot3(Cross3(n, t), bitan[i]) < 0 {
+			w = -1
+		}
+		out[i] = t.Vec4(w)
+	}
+	return out
+}
+
+// FaceNormal returns the unit normal of the triangle abc, facing the side
+// from which the vertices appear counter-clockwise. It returns the zero
+// vector if the triangle is degenerate.
+func FaceNormal(a, b, c Vec3) Vec3 {
+	n := Cross3(b.Sub(a), c.Sub(a))
+	if n == (Vec3{}) {
+		return n
+	}
+	return Normalize3(n)
+}
+
+// ComputeFlatNormals returns the normal of each triangle of the indexed
+// triangle list, as given by FaceNormal. For flat shading, vertices must not
+// be shared between triangles, so each can take the normal of its own.
+func ComputeFlatNormals(positions []Vec3, indices []int) []Vec3 {
+	normals := make([]Vec3, len(indices)/3)
+	for i := range normals {
+		normals[i] = FaceNormal(positions[indices[3*i]], positions[indices[3*i+1]], positions[indices[3*i+2]])
+	}
+	return normals
+}
+
+// ComputeNormals returns smooth vertex normals for the indexed triangle
+// list: the area-weighted average of the normals of the triangles around
+// each vertex. Vertices on no triangle get +Z.
+func ComputeNormals(positions []Vec3, indices []int) []Vec3 {
+	normals := make([]Vec3, len(positions))
+	for i := 0; i+2 < len(indices); i += 3 {
+		i0, i1, i2 := indices[i], indices[i+1], indices[i+2]
+		// The cross product's length is twice the area, weighting the sum.
+		n := Cross3(positions[i1].Sub(positions[i0]), positions[i2].Sub(positions[i0]))
+		for _, j := range [3]int{i0, i1, i2} {
+			normals[j] = normals[j].Add(n)
+		}
+	}
+	for i, n := range normals {
+		if n == (Vec3{}) {
+			normals[i] = Vec3{0, 0, 1}
+			continue
+		}
+		normals[i] = Normalize3(n)
+	}
+	return normals
+}
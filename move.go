@@ -0,0 +1,119 @@
+package vec
+
+import "math"
+
+// SlideResult is the outcome of MoveAndSlide.
+type SlideResult struct {
+	Pos Vec2 // final position
+	Vel Vec2 // velocity with the components into contacted surfaces removed
+
+	// Normals are the contact normals encountered, pointing away from the surfaces.
+	Normals []Vec2
+}
+
+// maxFloorCos is the cosine of the steepest slope that still counts as
+// floor, 45 degrees.
+const maxFloorCos = math.Sqrt2 / 2
+
+// OnGround reports whether any contact was a floor, a surface facing up
+// within 45 degrees. up is the world's up direction, such as (0, 1), or
+// (0, -1) in screen coordinates.
+func (r SlideResult) OnGround(up Vec2) bool {
+	for _, n := range r.Normals {
+		if Dot2(n, Normalize2(up)) >= maxFloorCos {
+			return true
+		}
+	}
+	return false
+}
+
+// OnCeiling reports whether any contact was a ceiling, a surface facing down
+// within 45 degrees. up is the world's up direction.
+func (r SlideResult) OnCeiling(up Vec2) bool {
+	for _, n := range r.Normals {
+		if Dot2(n, Normalize2(up)) <= -maxFloorCos {
+			return true
+		}
+	}
+	return false
+}
+
+// OnWall reports whether any contact was neither floor nor ceiling.
+// up is the world's up direction.
+func (r SlideResult) OnWall(up Vec2) bool {
+	for _, n := range r.Normals {
+		if math.Abs(Dot2(n, Normalize2(up))) < maxFloorCos {
+			return true
+		}
+	}
+	return false
+}
+
+// slideSkin is the gap MoveAndSlide keeps between the collider and contacted
+// surfaces, so that rounding error never leaves them overlapping.
+const slideSkin = 1e-6
+
+// MoveAndSlide moves collider, given relative to pos, by vel through world.
+// vel is the displacement for this step, typically velocity times the time
+// step. On contact the collider stops at the surface and the rest of the
+// motion continues along it, up to maxSlides times; the contact after that
+// leaves the collider at the surface, so maxSlides 0 only moves to contact.
+//
+// Obstacles the collider already overlaps do not block motion out of or
+// along them, so an embedded collider can free itself.
+// The collider and world shapes must be supported by Sweep.
+func MoveAndSlide(pos, vel Vec2, collider Shape2, world []Shape2, maxSlides int) SlideResult {
+	res := SlideResult{Pos: pos, Vel: vel}
+	remaining := vel
+	for range maxSlides + 1 {
+		if remaining.Eqs(0) {
+			break
+		}
+		shape := translateShape(collider, res.Pos)
+		hit, toi, n := false, math.Inf(1), Vec2{}
+		for _, o := range world {
+			h, t, nn := Sweep(shape, remaining, o)
+			if !h || (t == 0 && Dot2(remaining, nn) >= 0) {
+				continue
+			}
+			if t < toi {
+				hit, toi, n = true, t, nn
+			}
+		}
+		if !hit {
+			res.Pos = res.Pos.Add(remaining)
+			break
+		}
+
+		// Advance to just short of the contact, then slide along the surface.
+		l := Len2(remaining)
+		advance := max(toi*l-slideSkin, 0)
+		res.Pos = res.Pos.Add(remaining.Scale(advance / l))
+		remaining = remaining.Scale(1 - advance/l)
+		remaining = remaining.Sub(n.Scale(Dot2(remaining, n)))
+		if d := Dot2(res.Vel, n); d < 0 {
+			res.Vel = res.Vel.Sub(n.Scale(d))
+		}
+		res.Normals = append(res.Normals, n)
+	}
+	return res
+}
+
+// translateShape returns s moved by d.
+func translateShape(s Shape2, d Vec2) Shape2 {
+	switch s := s.(type) {
+	case Circle:
+		return s.Translate(d)
+	case Rect:
+		return s.Translate(d)
+	case RoundedRect:
+		return s.Translate(d)
+	case Capsule2:
+		return s.Translate(d)
+	case Segment2:
+		return s.Translate(d)
+	case Polygon:
+		return s.Translate(d)
+	}
+	panic("vec: unsupported shape type for MoveAndSlide")
+}
@@ -0,0 +1,79 @@
+package vec
+
+import "iter"
+
+// Neighbors4 returns the 4 cells sharing an edge with v, counter-clockwise
+// from +X.
+func Neighbors4(v Vec2i) [4]Vec2i {
+	var ns [4]Vec2i
+	for i, d := range gridDirs4 {
+		ns[i] = v.Add(d)
+	}
+	return ns
+}
+
+// Neighbors8 returns the 8 cells surrounding v, counter-clockwise from +X.
+func Neighbors8(v Vec2i) [8]Vec2i {
+	var ns [8]Vec2i
+	for i, d := range gridDirs8 {
+		ns[i] = v.Add(d)
+	}
+	return ns
+}
+
+// NeighborsRange returns an iterator over the cells within Chebyshev
+// distance radius of v, that is the (2*radius+1)² square around it,
+// excluding v itself. Cells are visited in row-major order.
+func NeighborsRange(v Vec2i, radius int) iter.Seq[Vec2i] {
+	return func(yield func(Vec2i) bool) {
+		for y := v.Y - radius; y <= v.Y+radius; y++ {
+			for x := v.X - radius; x <= v.X+radius; x++ {
+				if (x != v.X || y != v.Y) && !yield(Vec2i{x, y}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Hex grids use axial coordinates, with X as the q axis and Y as the r
+// axis; the implicit third cube coordinate is -q-r.
+
+// hexDirs lists the axial neighbor offsets in counter-clockwise order.
+var hexDirs = [6]Vec2i{{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1}}
+
+// HexNeighbors returns the 6 hexes adjacent to h.
+func HexNeighbors(h Vec2i) [6]Vec2i {
+	var ns [6]Vec2i
+	for i, d := range hexDirs {
+		ns[i] = h.Add(d)
+	}
+	return ns
+}
+
+// HexDistance returns the number of steps between hexes a and b.
+func HexDistance(a, b Vec2i) int {
+	d := a.Sub(b)
+	return (absInt(d.X) + absInt(d.Y) + absInt(d.X+d.Y)) / 2
+}
+
+// HexNeighborsRange returns an iterator over the hexes within distance
+// radius of h, excluding h itself.
+func HexNeighborsRange(h Vec2i, radius int) iter.Seq[Vec2i] {
+	return func(yield func(Vec2i) bool) {
+		for q := -radius; q <= radius; q++ {
+			for r := max(-radius, -q-radius); r <= min(radius, -q+radius); r++ {
+				if (q != 0 || r != 0) && !yield(h.Add(Vec2i{q, r})) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
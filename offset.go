@@ -0,0 +1,90 @@
+package vec
+
+import "math"
+
+// JoinStyle selects how OffsetPolygon fills the gap that opens at a corner
+// when its edges are pushed apart.
+type JoinStyle int
+
+const (
+	// JoinMiter extends the edges until they meet in a sharp corner. Corners
+	// sharper than the miter limit of 4, as in SVG, are beveled instead.
+	JoinMiter JoinStyle = iota
+	// JoinRound rounds the corner with a circular arc.
+	JoinRound
+	// JoinBevel cuts the corner off with a straight edge.
+	JoinBevel
+)
+
+const (
+	// miterLimit is the largest ratio of miter length to offset distance.
+	miterLimit = 4
+	// roundJoinStep is the largest angle spanned by one segment of a round join.
+	roundJoinStep = math.Pi / 16
+)
+
+// OffsetPolygon returns poly with every edge moved distance along its
+// normal: outward for a positive distance and inward for a negative one,
+// whichever the winding of poly. Corners are joined according to join, and
+// the result keeps the winding of poly.
+//
+// Loops that form where distance exceeds the size of a feature of poly,
+// such as when deflating a narrow spike, are not removed.
+func OffsetPolygon(poly Polygon, distance float64, join JoinStyle) Polygon {
+	var p Polygon
+	for i, v := range poly {
+		if v != poly[(i+1)%len(poly)] {
+			p = append(p, v)
+		}
+	}
+	if len(p) < 2 || distance == 0 {
+		return append(Polygon(nil), p...)
+	}
+
+	// Edge i runs from p[i] to p[i+1]; k scales its unit right-hand normal
+	// to the offset, which is outward for counter-clockwise polygons.
+	k := distance
+	if p.SignedArea() < 0 {
+		k = -k
+	}
+	n := len(p)
+	normals := make([]Vec2, n)
+	for i := range p {
+		e := Normalize2(p[(i+1)%n].Sub(p[i]))
+		normals[i] = Vec2{e.Y, -e.X}
+	}
+
+	out := make(Polygon, 0, n)
+	for i, v := range p {
+		n0, n1 := normals[(i+n-1)%n], normals[i]
+		a, b := v.Add(n0.Scale(k)), v.Add(n1.Scale(k))
+		cross, dot := Cross2(n0, n1), Dot2(n0, n1)
+		switch {
+		case math.Abs(cross) < 1e-12 && dot > 0:
+			out = append(out, b)
+		case cross*k < 0:
+			// The edges overlap at this corner: meet where the offset lines cross.
+			if 1+dot < 1e-12 {
+				out = append(out, a, b)
+			} else {
+				out = append(out, v.Add(n0.Add(n1).Scale(k/(1+dot))))
+			}
+		case join == JoinMiter && 1+dot > 0 && 2/(1+dot) <= miterLimit*miterLimit:
+			out = append(out, v.Add(n0.Add(n1).Scale(k/(1+dot))))
+		case join == JoinRound:
+			angle := math.Atan2(cross, dot)
+			if math.Abs(cross) < 1e-12 {
+				angle = math.Copysign(math.Pi, k) // the path doubles back
+			}
+			steps := int(math.Ceil(math.Abs(angle) / roundJoinStep))
+			out = append(out, a)
+			for s := 1; s < steps; s++ {
+				out = append(out, v.Add(Rotate2(n0, angle*float64(s)/float64(steps)).Scale(k)))
+			}
+			out = append(out, b)
+		default:
+			out = append(out, a, b)
+		}
+	}
+	return out
+}
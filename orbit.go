@@ -0,0 +1,49 @@
+package vec
+
+import "math"
+
+// PointOnCircle returns the point at angle radians counter-clockwise from
+// +X on the circle around center with the given radius.
+func PointOnCircle(center Vec2, radius, angle float64) Vec2 {
+	sin, cos := math.Sincos(angle)
+	return Vec2{center.X + radius*cos, center.Y + radius*sin}
+}
+
+// PointOnEllipse returns the point at parametric angle radians on the
+// axis-aligned ellipse around center with semi-axes radii.X and radii.Y.
+// The angle is measured before stretching, so it matches the polar angle
+// of the point only on the axes.
+func PointOnEllipse(center, radii Vec2, angle float64) Vec2 {
+	sin, cos := math.Sincos(angle)
+	return Vec2{center.X + radii.X*cos, center.Y + radii.Y*sin}
+}
+
+// OrbitAround returns point moved around center for dt at angularVel
+// radians per unit time, counter-clockwise for positive angularVel.
+// The distance to center is kept.
+func OrbitAround(point, center Vec2, angularVel, dt float64) Vec2 {
+	return center.Add(Rotate2(point.Sub(center), angularVel*dt))
+}
+
+// AngleAround returns the angular position of point around center, in
+// radians counter-clockwise from +X.
+func AngleAround(point, center Vec2) float64 { return Angle2(point.Sub(center)) }
+
+// TangentialVelocity returns the linear velocity of point when it turns
+// around center at angularVel radians per unit time.
+func TangentialVelocity(point, center Vec2, angularVel float64) Vec2 {
+	r := point.Sub(center)
+	return Vec2{-r.Y, r.X}.Scale(angularVel)
+}
+
+// AngularVelocity returns the rate in radians per unit time at which a
+// point at point moving with velocity vel turns around center. Motion
+// toward or away from center does not count. Returns 0 if point is center.
+func AngularVelocity(point, center, vel Vec2) float64 {
+	r := point.Sub(center)
+	l := LenSq2(r)
+	if l == 0 {
+		return 0
+	}
+	return Cross2(r, vel) / l
+}
@@ -0,0 +1,242 @@
+package vec
+
+import "unsafe"
+
+// Overflow-aware arithmetic for integer vectors. Go integer arithmetic wraps
+// silently, which is easy to miss in tile and chunk coordinate math. The
+// Checked functions report overflow in any component, the Saturating
+// functions clamp each component to the range of S, and the Wrapping
+// functions make the default wrapping behavior explicit.
+
+// CheckedAdd2 returns a+b and reports whether no component overflowed.
+func CheckedAdd2[V Vec2like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	x, okX := checkedAdd(va.X, vb.X)
+	y, okY := checkedAdd(va.Y, vb.Y)
+	return V(Vec2g[S]{x, y}), okX && okY
+}
+
+// CheckedSub2 returns a-b and reports whether no component overflowed.
+func CheckedSub2[V Vec2like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	x, okX := checkedSub(va.X, vb.X)
+	y, okY := checkedSub(va.Y, vb.Y)
+	return V(Vec2g[S]{x, y}), okX && okY
+}
+
+// CheckedMul2 returns the component-wise product a*b and reports whether no component overflowed.
+func CheckedMul2[V Vec2like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	x, okX := checkedMul(va.X, vb.X)
+	y, okY := checkedMul(va.Y, vb.Y)
+	return V(Vec2g[S]{x, y}), okX && okY
+}
+
+// SaturatingAdd2 returns a+b with each component clamped to the range of S.
+func SaturatingAdd2[V Vec2like[S], S Integer](a, b V) V {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return V(Vec2g[S]{saturatingAdd(va.X, vb.X), saturatingAdd(va.Y, vb.Y)})
+}
+
+// SaturatingSub2 returns a-b with each component clamped to the range of S.
+func SaturatingSub2[V Vec2like[S], S Integer](a, b V) V {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return V(Vec2g[S]{saturatingSub(va.X, vb.X), saturatingSub(va.Y, vb.Y)})
+}
+
+// WrappingAdd2 returns a+b, wrapping around on overflow.
+func WrappingAdd2[V Vec2like[S], S Integer](a, b V) V {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return V(Vec2g[S]{va.X + vb.X, va.Y + vb.Y})
+}
+
+// WrappingSub2 returns a-b, wrapping around on overflow.
+func WrappingSub2[V Vec2like[S], S Integer](a, b V) V {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return V(Vec2g[S]{va.X - vb.X, va.Y - vb.Y})
+}
+
+// WrappingMul2 returns the component-wise product a*b, wrapping around on overflow.
+func WrappingMul2[V Vec2like[S], S Integer](a, b V) V {
+	va, vb := Vec2g[S](a), Vec2g[S](b)
+	return V(Vec2g[S]{va.X * vb.X, va.Y * vb.Y})
+}
+
+// CheckedAdd3 returns a+b and reports whether no component overflowed.
+func CheckedAdd3[V Vec3like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	x, okX := checkedAdd(va.X, vb.X)
+	y, okY := checkedAdd(va.Y, vb.Y)
+	z, okZ := checkedAdd(va.Z, vb.Z)
+	return V(Vec3g[S]{x, y, z}), okX && okY && okZ
+}
+
+// CheckedSub3 returns a-b and reports whether no component overflowed.
+func CheckedSub3[V Vec3like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	x, okX := checkedSub(va.X, vb.X)
+	y, okY := checkedSub(va.Y, vb.Y)
+	z, okZ := checkedSub(va.Z, vb.Z)
+	return V(Vec3g[S]{x, y, z}), okX && okY && okZ
+}
+
+// CheckedMul3 returns the component-wise product a*b and reports whether no component overflowed.
+func CheckedMul3[V Vec3like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	x, okX := checkedMul(va.X, vb.X)
+	y, okY := checkedMul(va.Y, vb.Y)
+	z, okZ := checkedMul(va.Z, vb.Z)
+	return V(Vec3g[S]{x, y, z}), okX && okY && okZ
+}
+
+// SaturatingAdd3 returns a+b with each component clamped to the range of S.
+func SaturatingAdd3[V Vec3like[S], S Integer](a, b V) V {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return V(Vec3g[S]{saturatingAdd(va.X, vb.X), saturatingAdd(va.Y, vb.Y), saturatingAdd(va.Z, vb.Z)})
+}
+
+// SaturatingSub3 returns a-b with each component clamped to the range of S.
+func SaturatingSub3[V Vec3like[S], S Integer](a, b V) V {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return V(Vec3g[S]{saturatingSub(va.X, vb.X), saturatingSub(va.Y, vb.Y), saturatingSub(va.Z, vb.Z)})
+}
+
+// WrappingAdd3 returns a+b, wrapping around on overflow.
+func WrappingAdd3[V Vec3like[S], S Integer](a, b V) V {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return V(Vec3g[S]{va.X + vb.X, va.Y + vb.Y, va.Z + vb.Z})
+}
+
+// WrappingSub3 returns a-b, wrapping around on overflow.
+func WrappingSub3[V Vec3like[S], S Integer](a, b V) V {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return V(Vec3g[S]{va.X - vb.X, va.Y - vb.Y, va.Z - vb.Z})
+}
+
+// WrappingMul3 returns the component-wise product a*b, wrapping around on overflow.
+func WrappingMul3[V Vec3like[S], S Integer](a, b V) V {
+	va, vb := Vec3g[S](a), Vec3g[S](b)
+	return V(Vec3g[S]{va.X * vb.X, va.Y * vb.Y, va.Z * vb.Z})
+}
+
+// CheckedAdd4 returns a+b and reports whether no component overflowed.
+func CheckedAdd4[V Vec4like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	x, okX := checkedAdd(va.X, vb.X)
+	y, okY := checkedAdd(va.Y, vb.Y)
+	z, okZ := checkedAdd(va.Z, vb.Z)
+	w, okW := checkedAdd(va.W, vb.W)
+	return V(Vec4g[S]{x, y, z, w}), okX && okY && okZ && okW
+}
+
+// CheckedSub4 returns a-b and reports whether no component overflowed.
+func CheckedSub4[V Vec4like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	x, okX := checkedSub(va.X, vb.X)
+	y, okY := checkedSub(va.Y, vb.Y)
+	z, okZ := checkedSub(va.Z, vb.Z)
+	w, okW := checkedSub(va.W, vb.W)
+	return V(Vec4g[S]{x, y, z, w}), okX && okY && okZ && okW
+}
+
+// CheckedMul4 returns the component-wise product a*b and reports whether no component overflowed.
+func CheckedMul4[V Vec4like[S], S Integer](a, b V) (V, bool) {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	x, okX := checkedMul(va.X, vb.X)
+	y, okY := checkedMul(va.Y, vb.Y)
+	z, okZ := checkedMul(va.Z, vb.Z)
+	w, okW := checkedMul(va.W, vb.W)
+	return V(Vec4g[S]{x, y, z, w}), okX && okY && okZ && okW
+}
+
+// SaturatingAdd4 returns a+b with each component clamped to the range of S.
+func SaturatingAdd4[V Vec4like[S], S Integer](a, b V) V {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return V(Vec4g[S]{saturatingAdd(va.X, vb.X), saturatingAdd(va.Y, vb.Y), saturatingAdd(va.Z, vb.Z), saturatingAdd(va.W, vb.W)})
+}
+
+// SaturatingSub4 returns a-b with each component clamped to the range of S.
+func SaturatingSub4[V Vec4like[S], S Integer](a, b V) V {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return V(Vec4g[S]{saturatingSub(va.X, vb.X), saturatingSub(va.Y, vb.Y), saturatingSub(va.Z, vb.Z), saturatingSub(va.W, vb.W)})
+}
+
+// WrappingAdd4 returns a+b, wrapping around on overflow.
+func WrappingAdd4[V Vec4like[S], S Integer](a, b V) V {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return V(Vec4g[S]{va.X + vb.X, va.Y + vb.Y, va.Z + vb.Z, va.W + vb.W})
+}
+
+// WrappingSub4 returns a-b, wrapping around on overflow.
+func WrappingSub4[V Vec4like[S], S Integer](a, b V) V {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return V(Vec4g[S]{va.X - vb.X, va.Y - vb.Y, va.Z - vb.Z, va.W - vb.W})
+}
+
+// WrappingMul4 returns the component-wise product a*b, wrapping around on overflow.
+func WrappingMul4[V Vec4like[S], S Integer](a, b V) V {
+	va, vb := Vec4g[S](a), Vec4g[S](b)
+	return V(Vec4g[S]{va.X * vb.X, va.Y * vb.Y, va.Z * vb.Z, va.W * vb.W})
+}
+
+// intRange returns the smallest and largest values of S.
+func intRange[S Integer]() (lo, hi S) {
+	var z S
+	bits := 8 * unsafe.Sizeof(z)
+	if !isSigned[S]() {
+		return 0, ^z
+	}
+	hi = S(uint64(1)<<(bits-1) - 1)
+	return ^hi, hi
+}
+
+func checkedAdd[S Integer](a, b S) (S, bool) {
+	s := a + b
+	return s, (s > a) == (b > 0)
+}
+
+func checkedSub[S Integer](a, b S) (S, bool) {
+	d := a - b
+	if !isSigned[S]() {
+		return d, d <= a
+	}
+	return d, (d < a) == (b > 0)
+}
+
+func checkedMul[S Integer](a, b S) (S, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if isSigned[S]() {
+		lo, _ := intRange[S]()
+		if neg1 := ^S(0); (a == neg1 && b == lo) || (b == neg1 && a == lo) {
+			return p, false
+		}
+	}
+	return p, p/a == b
+}
+
+func saturatingAdd[S Integer](a, b S) S {
+	s, ok := checkedAdd(a, b)
+	if ok {
+		return s
+	}
+	lo, hi := intRange[S]()
+	if b > 0 {
+		return hi
+	}
+	return lo
+}
+
+func saturatingSub[S Integer](a, b S) S {
+	d, ok := checkedSub(a, b)
+	if ok {
+		return d
+	}
+	lo, hi := intRange[S]()
+	if b > 0 {
+		return lo
+	}
+	return hi
+}
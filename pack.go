@@ -0,0 +1,237 @@
+package vec
+
+import "math"
+
+// Packing of float vectors into compact formats for GPU vertex attributes.
+
+// FloatToHalf converts f to the bit pattern of the nearest IEEE 754
+// half-precision float, rounding ties to even. Values too large for a half
+// become infinities and NaNs stay NaNs.
+func FloatToHalf(f float64) uint16 {
+	bits := math.Float64bits(f)
+	sign := uint16(bits>>48) & 0x8000
+	exp := int(bits>>52) & 0x7ff
+	mant := bits & (1<<52 - 1)
+	switch {
+	case exp == 0x7ff && mant != 0:
+		return sign | 0x7e00 | uint16(mant>>42)
+	case exp == 0x7ff:
+		return sign | 0x7c00
+	case exp == 0:
+		exp = 1 // subnormal float64: no implicit bit
+	default:
+		mant |= 1 << 52
+	}
+
+	// Round mant to the half's precision: 10 fraction bits for normals,
+	// or units of 2^-24 for subnormals. A rounding carry propagates into
+	// the exponent naturally.
+	e := exp - 1023
+	var h uint64
+	if e >= -14 {
+		h = uint64(e+15)<<10 + roundShift(mant, 42) - 1<<10
+	} else {
+		h = roundShift(mant, uint(28-e))
+	}
+	if h >= 0x7c00 {
+		return sign | 0x7c00
+	}
+	return sign | uint16(h)
+}
+
+// roundShift returns m>>s rounded to nearest, ties to even.
+func roundShift(m uint64, s uint) uint64 {
+	if s >= 64 {
+		return 0
+	}
+	q := m >> s
+	r := m & (1<<s - 1)
+	half := uint64(1) << s >> 1
+	if r > half || (r == half && q&1 == 1) {
+		q++
+	}
+	return q
+}
+
+// HalfToFloat converts the half-precision bit pattern h to a float32,
+// which represents every half exactly.
+func HalfToFloat(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0 && mant == 0:
+		return math.Float32frombits(sign)
+	case exp == 0:
+		// Subnormal: normalize the mantissa.
+		exp = 1
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		mant &= 0x3ff
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// PackHalf2 returns the components of v as half-precision bit patterns.
+func PackHalf2[V Vec2like[S], S Float](v V) [2]uint16 {
+	vv := Vec2g[S](v)
+	return [2]uint16{FloatToHalf(float64(vv.X)), FloatToHalf(float64(vv.Y))}
+}
+
+// UnpackHalf2 returns the vector whose components have the half-precision
+// bit patterns h.
+func UnpackHalf2(h [2]uint16) Vec2g[float32] {
+	return Vec2g[float32]{HalfToFloat(h[0]), HalfToFloat(h[1])}
+}
+
+// PackHalf3 returns the components of v as half-precision bit patterns.
+func PackHalf3[V Vec3like[S], S Float](v V) [3]uint16 {
+	vv := Vec3g[S](v)
+	return [3]uint16{FloatToHalf(float64(vv.X)), FloatToHalf(float64(vv.Y)), FloatToHalf(float64(vv.Z))}
+}
+
+// UnpackHalf3 returns the vector whose components have the half-precision
+// bit patterns h.
+func UnpackHalf3(h [3]uint16) Vec3g[float32] {
+	return Vec3g[float32]{HalfToFloat(h[0]), HalfToFloat(h[1]), HalfToFloat(h[2])}
+}
+
+// PackHalf4 returns the components of v as half-precision bit patterns.
+func PackHalf4[V Vec4like[S], S Float](v V) [4]uint16 {
+	vv := Vec4g[S](v)
+	return [4]uint16{FloatToHalf(float64(vv.X)), FloatToHalf(float64(vv.Y)), FloatToHalf(float64(vv.Z)), FloatToHalf(float64(vv.W))}
+}
+
+// UnpackHalf4 returns the vector whose components have the half-precision
+// bit patterns h.
+func UnpackHalf4(h [4]uint16) Vec4g[float32] {
+	return Vec4g[float32]{HalfToFloat(h[0]), HalfToFloat(h[1]), HalfToFloat(h[2]), HalfToFloat(h[3])}
+}
+
+// The following functions pack normalized values into uint32 formats with
+// the first component in the lowest bits, matching the GLSL packUnorm and
+// packSnorm functions and the Vulkan and WebGPU vertex formats unorm8x4,
+// snorm8x4, unorm16x2, snorm16x2 and unorm10-10-10-2. Unorm components are
+// clamped to [0, 1] and snorm components to [-1, 1] before rounding.
+
+// PackUnorm4x8 packs v into four unsigned normalized bytes.
+func PackUnorm4x8(v Vec4) uint32 {
+	return packUnorm(v.X, 8) | packUnorm(v.Y, 8)<<8 | packUnorm(v.Z, 8)<<16 | packUnorm(v.W, 8)<<24
+}
+
+// UnpackUnorm4x8 is the inverse of PackUnorm4x8.
+func UnpackUnorm4x8(p uint32) Vec4 {
+	return Vec4{unpackUnorm(p, 8), unpackUnorm(p>>8, 8), unpackUnorm(p>>16, 8), unpackUnorm(p>>24, 8)}
+}
+
+// PackSnorm4x8 packs v into four signed normalized bytes.
+func PackSnorm4x8(v Vec4) uint32 {
+	return packSnorm(v.X, 8) | packSnorm(v.Y, 8)<<8 | packSnorm(v.Z, 8)<<16 | packSnorm(v.W, 8)<<24
+}
+
+// UnpackSnorm4x8 is the inverse of PackSnorm4x8.
+func UnpackSnorm4x8(p uint32) Vec4 {
+	return Vec4{unpackSnorm(p, 8), unpackSnorm(p>>8, 8), unpackSnorm(p>>16, 8), unpackSnorm(p>>24, 8)}
+}
+
+// PackUnorm2x16 packs v into two unsigned normalized 16-bit values.
+func PackUnorm2x16(v Vec2) uint32 { return packUnorm(v.X, 16) | packUnorm(v.Y, 16)<<16 }
+
+// UnpackUnorm2x16 is the inverse of PackUnorm2x16.
+func UnpackUnorm2x16(p uint32) Vec2 { return Vec2{unpackUnorm(p, 16), unpackUnorm(p>>16, 16)} }
+
+// PackSnorm2x16 packs v into two signed normalized 16-bit values.
+func PackSnorm2x16(v Vec2) uint32 { return packSnorm(v.X, 16) | packSnorm(v.Y, 16)<<16 }
+
+// UnpackSnorm2x16 is the inverse of PackSnorm2x16.
+func UnpackSnorm2x16(p uint32) Vec2 { return Vec2{unpackSnorm(p, 16), unpackSnorm(p>>16, 16)} }
+
+// PackUnorm1010102 packs the x, y, z components of v into 10 bits each and
+// w into the top 2 bits, as in the RGB10A2 formats.
+func PackUnorm1010102(v Vec4) uint32 {
+	return packUnorm(v.X, 10) | packUnorm(v.Y, 10)<<10 | packUnorm(v.Z, 10)<<20 | packUnorm(v.W, 2)<<30
+}
+
+// UnpackUnorm1010102 is the inverse of PackUnorm1010102.
+func UnpackUnorm1010102(p uint32) Vec4 {
+	return Vec4{unpackUnorm(p, 10), unpackUnorm(p>>10, 10), unpackUnorm(p>>20, 10), unpackUnorm(p>>30, 2)}
+}
+
+// packUnorm returns c in [0, 1] as an unsigned integer of the given bits.
+func packUnorm(c float64, bits uint) uint32 {
+	m := float64(uint32(1)<<bits - 1)
+	return uint32(math.Round(min(max(c, 0), 1) * m))
+}
+
+// unpackUnorm returns the low bits of p as a value in [0, 1].
+func unpackUnorm(p uint32, bits uint) float64 {
+	m := uint32(1)<<bits - 1
+	return float64(p&m) / float64(m)
+}
+
+// packSnorm returns c in [-1, 1] as a two's complement integer of the
+// given bits.
+func packSnorm(c float64, bits uint) uint32 {
+	m := float64(uint32(1)<<(bits-1) - 1)
+	return uint32(int32(math.Round(min(max(c, -1), 1)*m))) & (1<<bits - 1)
+}
+
+// unpackSnorm returns the low bits of p, a two's complement integer, as a
+// value in [-1, 1]. The most negative integer also maps to -1.
+func unpackSnorm(p uint32, bits uint) float64 {
+	n := int32(p<<(32-bits)) >> (32 - bits)
+	m := float64(uint32(1)<<(bits-1) - 1)
+	return max(float64(n)/m, -1)
+}
+
+// EncodeOct maps the unit vector n to a point in [-1, 1]² by projecting it
+// onto an octahedron and unfolding the lower half over the corners.
+// n need not be normalized but must not be zero.
+func EncodeOct(n Vec3) Vec2 {
+	l1 := math.Abs(n.X) + math.Abs(n.Y) + math.Abs(n.Z)
+	p := Vec2{n.X / l1, n.Y / l1}
+	if n.Z < 0 {
+		p = Vec2{(1 - math.Abs(p.Y)) * signNotZero(p.X), (1 - math.Abs(p.X)) * signNotZero(p.Y)}
+	}
+	return p
+}
+
+// DecodeOct is the inverse of EncodeOct, returning a unit vector.
+func DecodeOct(e Vec2) Vec3 {
+	v := Vec3{e.X, e.Y, 1 - math.Abs(e.X) - math.Abs(e.Y)}
+	if v.Z < 0 {
+		v.X, v.Y = (1-math.Abs(e.Y))*signNotZero(e.X), (1-math.Abs(e.X))*signNotZero(e.Y)
+	}
+	return Normalize3(v)
+}
+
+// PackOct16 encodes the unit vector n into 16 bits, two snorm bytes of its
+// octahedral encoding, with a maximum angular error of about 1 degree.
+func PackOct16(n Vec3) uint16 {
+	e := EncodeOct(n)
+	return uint16(packSnorm(e.X, 8) | packSnorm(e.Y, 8)<<8)
+}
+
+// UnpackOct16 is the inverse of PackOct16.
+func UnpackOct16(p uint16) Vec3 {
+	return DecodeOct(Vec2{unpackSnorm(uint32(p), 8), unpackSnorm(uint32(p)>>8, 8)})
+}
+
+// PackOct32 encodes the unit vector n into 32 bits, two snorm 16-bit values
+// of its octahedral encoding, accurate to about 0.004 degrees.
+func PackOct32(n Vec3) uint32 { return PackSnorm2x16(EncodeOct(n)) }
+
+// UnpackOct32 is the inverse of PackOct32.
+func UnpackOct32(p uint32) Vec3 { return DecodeOct(UnpackSnorm2x16(p)) }
+
+// signNotZero returns 1 for x >= 0 and -1 otherwise.
+func signNotZero(x float64) float64 {
+	if x < 0 {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,119 @@
+package vec
+
+import (
+	"cmp"
+	"slices"
+)
+
+// RectPacker places rectangles into a fixed-size atlas without overlap,
+// using the bottom-left skyline algorithm: it tracks the top edge of the
+// packed area as a list of horizontal segments and puts each new rectangle
+// where its top would end up lowest. Placements start at (0, 0) and grow
+// toward +Y.
+type RectPacker struct {
+	size    Vec2i
+	skyline []skylineSegment
+}
+
+// skylineSegment is a horizontal segment of the skyline at height y,
+// spanning [x, x+w).
+type skylineSegment struct{ x, y, w int }
+
+// NewRectPacker returns an empty packer for an atlas of the given size.
+func NewRectPacker(size Vec2i) *RectPacker {
+	return &RectPacker{size: size, skyline: []skylineSegment{{0, 0, size.X}}}
+}
+
+// Size returns the size of the atlas.
+func (p *RectPacker) Size() Vec2i { return p.size }
+
+// Insert places a rectangle of the given size and returns where it went.
+// Returns false if there is no room for it.
+func (p *RectPacker) Insert(size Vec2i) (Recti, bool) {
+	if size.X <= 0 || size.Y <= 0 {
+		return Recti{}, size.X >= 0 && size.Y >= 0
+	}
+	best, bestX, bestY, bestTop := -1, 0, 0, 0
+	for i, s := range p.skyline {
+		y, ok := p.fit(i, size)
+		if !ok {
+			continue
+		}
+		if best < 0 || y+size.Y < bestTop {
+			best, bestX, bestY, bestTop = i, s.x, y, y+size.Y
+		}
+	}
+	if best < 0 {
+		return Recti{}, false
+	}
+	p.raise(best, skylineSegment{bestX, bestTop, size.X})
+	min := Vec2i{bestX, bestY}
+	return Recti{min, min.Add(size)}, true
+}
+
+// fit returns the height at which a rectangle of the given size rests when
+// its left edge is at the start of segment i.
+func (p *RectPacker) fit(i int, size Vec2i) (int, bool) {
+	x := p.skyline[i].x
+	if x+size.X > p.size.X {
+		return 0, false
+	}
+	y := 0
+	for j := i; j < len(p.skyline) && p.skyline[j].x < x+size.X; j++ {
+		y = max(y, p.skyline[j].y)
+	}
+	return y, y+size.Y <= p.size.Y
+}
+
+// raise inserts the segment s at index i, trimming the segments it covers
+// and merging neighbors of equal height.
+func (p *RectPacker) raise(i int, s skylineSegment) {
+	p.skyline = slices.Insert(p.skyline, i, s)
+	end := s.x + s.w
+	for j := i + 1; j < len(p.skyline); {
+		n := &p.skyline[j]
+		if n.x >= end {
+			break
+		}
+		if n.x+n.w <= end {
+			p.skyline = slices.Delete(p.skyline, j, j+1)
+			continue
+		}
+		n.w -= end - n.x
+		n.x = end
+		break
+	}
+	for j := 0; j+1 < len(p.skyline); {
+		a, b := &p.skyline[j], p.skyline[j+1]
+		if a.y == b.y {
+			a.w += b.w
+			p.skyline = slices.Delete(p.skyline, j+1, j+2)
+			continue
+		}
+		j++
+	}
+}
+
+// PackRects packs rectangles of the given sizes into an atlas of size
+// atlas and returns their placements in the order of sizes. They are
+// inserted tallest first, which packs more tightly than input order.
+// Returns false if some did not fit; their placements are the zero Recti.
+func PackRects(atlas Vec2i, sizes []Vec2i) ([]Recti, bool) {
+	order := make([]int, len(sizes))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Or(cmp.Compare(sizes[b].Y, sizes[a].Y), cmp.Compare(sizes[b].X, sizes[a].X))
+	})
+
+	p := NewRectPacker(atlas)
+	placements := make([]Recti, len(sizes))
+	all := true
+	for _, i := range order {
+		r, ok := p.Insert(sizes[i])
+		placements[i] = r
+		all = all && ok
+	}
+	return placements, all
+}
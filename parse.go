@@ -0,0 +1,131 @@
+package vec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unsafe"
+)
+
+// Parse2 parses a 2D vector from s.
+// The components may be separated by commas or spaces and optionally
+// enclosed in parentheses, brackets or braces, so "3,4", "3 4", "(3, 4)"
+// and "[3, 4]" are all accepted. This includes the output of String.
+func Parse2[S Scalar](s string) (Vec2g[S], error) {
+	var c [2]S
+	if err := parseComponents(s, c[:]); err != nil {
+		return Vec2g[S]{}, err
+	}
+	return Vec2g[S]{c[0], c[1]}, nil
+}
+
+// Parse3 parses a 3D vector from s. See Parse2 for the accepted forms.
+func Parse3[S Scalar](s string) (Vec3g[S], error) {
+	var c [3]S
+	if err := parseComponents(s, c[:]); err != nil {
+		return Vec3g[S]{}, err
+	}
+	return Vec3g[S]{c[0], c[1], c[2]}, nil
+}
+
+// Parse4 parses a 4D vector from s. See Parse2 for the accepted forms.
+func Parse4[S Scalar](s string) (Vec4g[S], error) {
+	var c [4]S
+	if err := parseComponents(s, c[:]); err != nil {
+		return Vec4g[S]{}, err
+	}
+	return Vec4g[S]{c[0], c[1], c[2], c[3]}, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using Parse2.
+func (a *Vec2g[S]) UnmarshalText(text []byte) error {
+	v, err := Parse2[S](string(text))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using Parse3.
+func (a *Vec3g[S]) UnmarshalText(text []byte) error {
+	v, err := Parse3[S](string(text))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using Parse4.
+func (a *Vec4g[S]) UnmarshalText(text []byte) error {
+	v, err := Parse4[S](string(text))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// Set implements flag.Value using Parse2, so a *Vec2g can be passed to
+// flag.Var and set with -size=800,600.
+func (a *Vec2g[S]) Set(s string) error { return a.UnmarshalText([]byte(s)) }
+
+// Set implements flag.Value using Parse3.
+func (a *Vec3g[S]) Set(s string) error { return a.UnmarshalText([]byte(s)) }
+
+// Set implements flag.Value using Parse4.
+func (a *Vec4g[S]) Set(s string) error { return a.UnmarshalText([]byte(s)) }
+
+// parseComponents parses exactly len(dst) components from s into dst.
+func parseComponents[S Scalar](s string, dst []S) error {
+	body := strings.TrimSpace(s)
+	if len(body) >= 2 {
+		switch body[0:1] + body[len(body)-1:] {
+		case "()", "[]", "{}":
+			body = body[1 : len(body)-1]
+		}
+	}
+
+	var fields []string
+	if strings.Contains(body, ",") {
+		fields = strings.Split(body, ",")
+	} else {
+		fields = strings.FieldsFunc(body, unicode.IsSpace)
+	}
+	if len(fields) != len(dst) {
+		return fmt.Errorf("vec: parsing %q: expected %d components, got %d", s, len(dst), len(fields))
+	}
+	for i, f := range fields {
+		c, err := parseScalar[S](strings.TrimSpace(f))
+		if err != nil {
+			return fmt.Errorf("vec: parsing %q: %w", s, err)
+		}
+		dst[i] = c
+	}
+	return nil
+}
+
+// parseScalar parses s as a number of type S, rejecting values that do not fit.
+func parseScalar[S Scalar](s string) (S, error) {
+	var zero S
+	bits := int(unsafe.Sizeof(zero)) * 8
+	switch {
+	case isFloat[S]():
+		f, err := strconv.ParseFloat(s, bits)
+		return S(f), err
+	case isSigned[S]():
+		i, err := strconv.ParseInt(s, 10, bits)
+		return S(i), err
+	}
+	u, err := strconv.ParseUint(s, 10, bits)
+	return S(u), err
+}
+
+// isSigned reports whether S can hold negative values.
+func isSigned[S Scalar]() bool {
+	var x S
+	x--
+	return x < 0
+}
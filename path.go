@@ -0,0 +1,36 @@
+package vec
+
+// Path2 is a polyline through its points in order. A closed path repeats
+// its first point at the end.
+type Path2 []Vec2
+
+// Length returns the total length of the segments of p.
+func (p Path2) Length() float64 {
+	var l float64
+	for i := 1; i < len(p); i++ {
+		l += Len2(p[i].Sub(p[i-1]))
+	}
+	return l
+}
+
+// Closed reports whether p ends where it starts.
+func (p Path2) Closed() bool { return len(p) > 1 && p[0] == p[len(p)-1] }
+
+// SampleOnPath returns the point at arc-length fraction t along path, from
+// its first point at 0 to its last at 1. t is clamped to [0, 1].
+// Returns zero vector if path is empty.
+func SampleOnPath(path Path2, t float64) Vec2 {
+	if len(path) == 0 {
+		return Vec2{}
+	}
+	d := min(max(t, 0), 1) * path.Length()
+	for i := 1; i < len(path); i++ {
+		a, b := path[i-1], path[i]
+		l := Len2(b.Sub(a))
+		if d <= l && l > 0 {
+			return Lerp2(a, b, d/l)
+		}
+		d -= l
+	}
+	return path[len(path)-1]
+}
@@ -0,0 +1,53 @@
+package vec
+
+import "math"
+
+// PluckerLine is a directed 3D line in Plücker coordinates: its direction
+// Dir and its moment Moment, the cross product of any point on the line
+// with Dir. Relationships between lines reduce to dot products of these
+// without solving for intersection points, which keeps ray and edge tests
+// consistent where edges are shared, as between adjacent triangles.
+type PluckerLine struct{ Dir, Moment Vec3 }
+
+// PluckerFromPoints returns the line through a and b, directed from a to b.
+func PluckerFromPoints(a, b Vec3) PluckerLine { return PluckerLine{b.Sub(a), Cross3(a, b)} }
+
+// PluckerFromRay returns the line along r.
+func PluckerFromRay(r Ray3) PluckerLine { return PluckerLine{r.Dir, Cross3(r.Origin, r.Dir)} }
+
+// Side returns the permuted inner product of l and o. It is zero when the
+// lines are coplanar, meeting or parallel, and otherwise its sign tells
+// which way o passes around l: positive when o turns around l the way the
+// fingers of a right hand curl with the thumb along l.Dir. Its magnitude is the distance between
+// the lines times the lengths of both directions and the sine of the angle
+// between them.
+func (l PluckerLine) Side(o PluckerLine) float64 {
+	return Dot3(l.Dir, o.Moment) + Dot3(o.Dir, l.Moment)
+}
+
+// ClosestToOrigin returns the point of l nearest to the origin.
+func (l PluckerLine) ClosestToOrigin() Vec3 {
+	return Cross3(l.Dir, l.Moment).Divs(LenSq3(l.Dir))
+}
+
+// Distance returns the shortest distance between the lines l and o.
+func (l PluckerLine) Distance(o PluckerLine) float64 {
+	c := Cross3(l.Dir, o.Dir)
+	if n := Len3(c); n > 1e-12*Len3(l.Dir)*Len3(o.Dir) {
+		return math.Abs(l.Side(o)) / n
+	}
+	// Parallel: the distance from a point of o to l.
+	p := o.ClosestToOrigin()
+	return Len3(Cross3(p, l.Dir).Sub(l.Moment)) / Len3(l.Dir)
+}
+
+// CrossesTriangle reports whether l passes through the triangle abc, from
+// either side, including its edges. The test only compares signs of Side,
+// so a line through a shared edge crosses one of the two adjacent
+// triangles at least, never slipping between them.
+func (l PluckerLine) CrossesTriangle(a, b, c Vec3) bool {
+	s1 := l.Side(PluckerFromPoints(a, b))
+	s2 := l.Side(PluckerFromPoints(b, c))
+	s3 := l.Side(PluckerFromPoints(c, a))
+	return (s1 >= 0 && s2 >= 0 && s3 >= 0) || (s1 <= 0 && s2 <= 0 && s3 <= 0)
+}
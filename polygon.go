@@ -0,0 +1,201 @@
+package vec
+
+import "math"
+
+// Polygon is a closed polygon given by its vertices in order.
+// The last vertex is implicitly connected back to the first.
+type Polygon []Vec2
+
+// SignedArea returns the signed area of p.
+// It is positive when the vertices are in counter-clockwise order.
+func (p Polygon) SignedArea() float64 {
+	var a float64
+	for i := range p {
+		a += Cross2(p[i], p[(i+1)%len(p)])
+	}
+	return a / 2
+}
+
+// Area returns the area of p.
+func (p Polygon) Area() float64 { return math.Abs(p.SignedArea()) }
+
+// Triangulate splits p into triangles by ear clipping and returns them as
+// index triples into p, wound counter-clockwise.
+// p may be concave and in either winding order, but must not self-intersect.
+func (p Polygon) Triangulate() [][3]int {
+	n := len(p)
+	if n < 3 {
+		return nil
+	}
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	if p.SignedArea() < 0 {
+		for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+			idx[i], idx[j] = idx[j], idx[i]
+		}
+	}
+
+	tris := make([][3]int, 0, n-2)
+	for len(idx) > 3 {
+		m := len(idx)
+		clipped := false
+		for i := range idx {
+			prev, cur, next := idx[(i+m-1)%m], idx[i], idx[(i+1)%m]
+			if !p.isEar(idx, prev, cur, next) {
+				continue
+			}
+			tris = append(tris, [3]int{prev, cur, next})
+			idx = append(idx[:i], idx[i+1:]...)
+			clipped = true
+			break
+		}
+		if !clipped {
+			// Only degenerate input (collinear or self-intersecting) gets here.
+			// Clip an arbitrary vertex so that the loop terminates.
+			tris = append(tris, [3]int{idx[m-1], idx[0], idx[1]})
+			idx = idx[1:]
+		}
+	}
+	return append(tris, [3]int{idx[0], idx[1], idx[2]})
+}
+
+// isEar reports whether the vertex cur forms an ear with its neighbors
+// among the remaining vertices idx of a counter-clockwise polygon.
+func (p Polygon) isEar(idx []int, prev, cur, next int) bool {
+	a, b, c := p[prev], p[cur], p[next]
+	if Orient2D(a, b, c) <= 0 {
+		return false // reflex or degenerate corner
+	}
+	for _, k := range idx {
+		if k == prev || k == cur || k == next {
+			continue
+		}
+		if pointInTriangle2(p[k], a, b, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// pointInTriangle2 reports whether p lies inside or on the boundary of the
+// counter-clockwise triangle abc.
+func pointInTriangle2(p, a, b, c Vec2) bool {
+	return Orient2D(a, b, p) >= 0 && Orient2D(b, c, p) >= 0 && Orient2D(c, a, p) >= 0
+}
+
+// Perimeter returns the length of the boundary of p.
+func (p Polygon) Perimeter() float64 {
+	var l float64
+	for i := range p {
+		l += Len2(p[(i+1)%len(p)].Sub(p[i]))
+	}
+	return l
+}
+
+// EdgeAt returns the point on the boundary of p at arc-length fraction t.
+// The boundary starts at the first vertex and follows the vertex order.
+func (p Polygon) EdgeAt(t float64) Vec2 {
+	if len(p) == 0 {
+		return Vec2{}
+	}
+	d := t * p.Perimeter()
+	for i := range p {
+		a, b := p[i], p[(i+1)%len(p)]
+		l := Len2(b.Sub(a))
+		if d <= l && l > 0 {
+			return Lerp2(a, b, d/l)
+		}
+		d -= l
+	}
+	return p[0]
+}
+
+// Centroid returns the center of mass of the area enclosed by p.
+// Unlike Mean2 of the vertices, it does not depend on how densely the
+// boundary is sampled. Returns the vertex mean if p has zero area.
+func (p Polygon) Centroid() Vec2 {
+	var c Vec2
+	var a float64
+	for i := range p {
+		v, w := p[i], p[(i+1)%len(p)]
+		cross := Cross2(v, w)
+		c = c.Add(v.Add(w).Scale(cross))
+		a += cross
+	}
+	if a == 0 {
+		return Mean2(p)
+	}
+	return c.Divs(3 * a)
+}
+
+// IsConvex reports whether p is convex. Collinear vertices are allowed.
+func (p Polygon) IsConvex() bool {
+	var sign float64
+	for i := range p {
+		a, b, c := p[i], p[(i+1)%len(p)], p[(i+2)%len(p)]
+		cross := Cross2(b.Sub(a), c.Sub(b))
+		if cross == 0 {
+			continue
+		}
+		if sign != 0 && (cross > 0) != (sign > 0) {
+			return false
+		}
+		sign = cross
+	}
+	return true
+}
+
+// Bounds returns the smallest rectangle containing p.
+func (p Polygon) Bounds() Rect {
+	var b Bounds2
+	for _, v := range p {
+		b.Add(v)
+	}
+	return b.Result()
+}
+
+// ContainsPoint reports whether q lies inside p or on its boundary.
+// p may be concave; self-intersecting polygons use the even-odd rule.
+func (p Polygon) ContainsPoint(q Vec2) bool {
+	inside := false
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		if closestOnSegment(q, a, b) == q {
+			return true
+		}
+		if (a.Y > q.Y) != (b.Y > q.Y) && q.X < a.X+(q.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// ClosestPoint returns the point of p nearest to q, which is q itself if q
+// lies inside p.
+func (p Polygon) ClosestPoint(q Vec2) Vec2 {
+	if len(p) == 0 || p.ContainsPoint(q) {
+		return q
+	}
+	best, bestD := q, math.Inf(1)
+	for i, a := range p {
+		c := closestOnSegment(q, a, p[(i+1)%len(p)])
+		if d := LenSq2(c.Sub(q)); d < bestD {
+			best, bestD = c, d
+		}
+	}
+	return best
+}
+
+// Project returns the range of Dot2(v, axis) over the points v of p.
+func (p Polygon) Project(axis Vec2) (min, max float64) { return projectPoints(p, axis) }
+
+// Translate returns a copy of p moved by d.
+func (p Polygon) Translate(d Vec2) Polygon {
+	q := make(Polygon, len(p))
+	for i, v := range p {
+		q[i] = v.Add(d)
+	}
+	return q
+}
@@ -0,0 +1,185 @@
+package vec
+
+import "math"
+
+// Robust geometric predicates after Shewchuk, "Adaptive Precision
+// Floating-Point Arithmetic and Fast Robust Geometric Predicates". Each
+// first evaluates the determinant in floating point and returns it when an
+// error bound proves its sign correct. Otherwise the determinant is
+// recomputed exactly with floating-point expansions, so the sign of the
+// result is always exact, including zero for degenerate input.
+
+const (
+	epsilon       = 1.0 / (1 << 53)
+	orient2DBound = (3 + 16*epsilon) * epsilon
+	orient3DBound = (7 + 56*epsilon) * epsilon
+	inCircleBound = (10 + 96*epsilon) * epsilon
+)
+
+// Orient2D returns a positive value if a, b, c are in counter-clockwise
+// order, negative if clockwise, and zero if collinear. The value
+// approximates twice the signed area of the triangle abc; its sign is exact.
+func Orient2D(a, b, c Vec2) float64 {
+	left := float64((a.X - c.X) * (b.Y - c.Y))
+	right := float64((a.Y - c.Y) * (b.X - c.X))
+	det := left - right
+	if math.Abs(det) > orient2DBound*(math.Abs(left)+math.Abs(right)) {
+		return det
+	}
+	e := expSum(expSum(crossExp(a, b), crossExp(b, c)), crossExp(c, a))
+	return expEstimate(e)
+}
+
+// Orient3D returns a positive value if d lies below the plane through a, b
+// and c, negative if above, and zero if the four points are coplanar.
+// Below is the side opposite the normal (b-a)×(c-a), from which a, b, c
+// appear clockwise. The value approximates six times the signed volume of
+// the tetrahedron; its sign is exact.
+func Orient3D(a, b, c, d Vec3) float64 {
+	ad, bd, cd := a.Sub(d), b.Sub(d), c.Sub(d)
+	t1 := float64(bd.Y*cd.Z) - float64(bd.Z*cd.Y)
+	t2 := float64(cd.Y*ad.Z) - float64(cd.Z*ad.Y)
+	t3 := float64(ad.Y*bd.Z) - float64(ad.Z*bd.Y)
+	det := float64(ad.X*t1) + float64(bd.X*t2) + float64(cd.X*t3)
+	perm := math.Abs(ad.X)*(math.Abs(bd.Y*cd.Z)+math.Abs(bd.Z*cd.Y)) +
+		math.Abs(bd.X)*(math.Abs(cd.Y*ad.Z)+math.Abs(cd.Z*ad.Y)) +
+		math.Abs(cd.X)*(math.Abs(ad.Y*bd.Z)+math.Abs(ad.Z*bd.Y))
+	if math.Abs(det) > orient3DBound*perm {
+		return det
+	}
+
+	adx, ady, adz := twoDiff(a.X, d.X), twoDiff(a.Y, d.Y), twoDiff(a.Z, d.Z)
+	bdx, bdy, bdz := twoDiff(b.X, d.X), twoDiff(b.Y, d.Y), twoDiff(b.Z, d.Z)
+	cdx, cdy, cdz := twoDiff(c.X, d.X), twoDiff(c.Y, d.Y), twoDiff(c.Z, d.Z)
+	e := expMul(adx, expSub(expMul(bdy, cdz), expMul(bdz, cdy)))
+	e = expSum(e, expMul(bdx, expSub(expMul(cdy, adz), expMul(cdz, ady))))
+	e = expSum(e, expMul(cdx, expSub(expMul(ady, bdz), expMul(adz, bdy))))
+	return expEstimate(e)
+}
+
+// InCircle returns a positive value if d lies inside the circle through a,
+// b and c, negative if outside, and zero if the four points are cocircular.
+// a, b, c must be in counter-clockwise order; the sign is reversed
+// otherwise. The sign of the result is exact.
+func InCircle(a, b, c, d Vec2) float64 {
+	ad, bd, cd := a.Sub(d), b.Sub(d), c.Sub(d)
+	alift := float64(ad.X*ad.X) + float64(ad.Y*ad.Y)
+	blift := float64(bd.X*bd.X) + float64(bd.Y*bd.Y)
+	clift := float64(cd.X*cd.X) + float64(cd.Y*cd.Y)
+	bc := float64(bd.X*cd.Y) - float64(cd.X*bd.Y)
+	ca := float64(cd.X*ad.Y) - float64(ad.X*cd.Y)
+	ab := float64(ad.X*bd.Y) - float64(bd.X*ad.Y)
+	det := alift*bc + blift*ca + clift*ab
+	perm := alift*(math.Abs(bd.X*cd.Y)+math.Abs(cd.X*bd.Y)) +
+		blift*(math.Abs(cd.X*ad.Y)+math.Abs(ad.X*cd.Y)) +
+		clift*(math.Abs(ad.X*bd.Y)+math.Abs(bd.X*ad.Y))
+	if math.Abs(det) > inCircleBound*perm {
+		return det
+	}
+
+	adx, ady := twoDiff(a.X, d.X), twoDiff(a.Y, d.Y)
+	bdx, bdy := twoDiff(b.X, d.X), twoDiff(b.Y, d.Y)
+	cdx, cdy := twoDiff(c.X, d.X), twoDiff(c.Y, d.Y)
+	lift := func(x, y []float64) []float64 { return expSum(expMul(x, x), expMul(y, y)) }
+	e := expMul(lift(adx, ady), expSub(expMul(bdx, cdy), expMul(cdx, bdy)))
+	e = expSum(e, expMul(lift(bdx, bdy), expSub(expMul(cdx, ady), expMul(adx, cdy))))
+	e = expSum(e, expMul(lift(cdx, cdy), expSub(expMul(adx, bdy), expMul(bdx, ady))))
+	return expEstimate(e)
+}
+
+// Floating-point expansions represent a number exactly as the sum of
+// nonoverlapping float64 components in increasing order of magnitude.
+
+// twoSum returns a+b exactly as the rounded sum and its rounding error.
+func twoSum(a, b float64) (sum, err float64) {
+	sum = a + b
+	bv := sum - a
+	av := sum - bv
+	err = (a - av) + (b - bv)
+	return sum, err
+}
+
+// twoProd returns a*b exactly as the rounded product and its rounding
+// error, which a fused multiply-add computes exactly.
+func twoProd(a, b float64) (prod, err float64) {
+	prod = a * b
+	return prod, math.FMA(a, b, -prod)
+}
+
+// twoDiff returns a-b as an expansion.
+func twoDiff(a, b float64) []float64 {
+	d, err := twoSum(a, -b)
+	return []float64{err, d}
+}
+
+// crossExp returns a.X*b.Y - a.Y*b.X as an expansion.
+func crossExp(a, b Vec2) []float64 {
+	p, pe := twoProd(a.X, b.Y)
+	q, qe := twoProd(-a.Y, b.X)
+	return expSum([]float64{pe, p}, []float64{qe, q})
+}
+
+// expGrow returns the expansion e+b.
+func expGrow(e []float64, b float64) []float64 {
+	h := make([]float64, 0, len(e)+1)
+	q := b
+	for _, c := range e {
+		var err float64
+		q, err = twoSum(q, c)
+		if err != 0 {
+			h = append(h, err)
+		}
+	}
+	return append(h, q)
+}
+
+// expSum returns the expansion e+f.
+func expSum(e, f []float64) []float64 {
+	for _, c := range f {
+		e = expGrow(e, c)
+	}
+	return e
+}
+
+// expSub returns the expansion e-f.
+func expSub(e, f []float64) []float64 {
+	for _, c := range f {
+		e = expGrow(e, -c)
+	}
+	return e
+}
+
+// expScale returns the expansion e*b.
+func expScale(e []float64, b float64) []float64 {
+	var h []float64
+	for _, c := range e {
+		p, pe := twoProd(c, b)
+		h = expSum(h, []float64{pe, p})
+	}
+	return h
+}
+
+// expMul returns the expansion e*f.
+func expMul(e, f []float64) []float64 {
+	var h []float64
+	for _, c := range f {
+		h = expSum(h, expScale(e, c))
+	}
+	return h
+}
+
+// expEstimate returns an approximation of e with its exact sign, which is
+// the sign of its largest nonzero component.
+func expEstimate(e []float64) float64 {
+	var s, top float64
+	for _, c := range e {
+		s += c
+		if c != 0 {
+			top = c
+		}
+	}
+	if s*top <= 0 {
+		return top
+	}
+	return s
+}
@@ -0,0 +1,99 @@
+package vec
+
+import "math"
+
+// The constructors below follow OpenGL conventions: view space is
+// right-handed with the camera looking down -Z, and clip space maps the
+// visible volume to [-1, 1] on every axis after the perspective divide.
+// Matrices transform column vectors, as in Mat4.MulVec.
+
+// LookAt returns the view matrix of a camera at eye looking toward target,
+// with up giving the upward direction.
+func LookAt(eye, target, up Vec3) Mat4 {
+	f := Normalize3(target.Sub(eye))
+	s := Normalize3(Cross3(f, up))
+	u := Cross3(s, f)
+	return Mat4{
+		{s.X, s.Y, s.Z, -Dot3(s, eye)},
+		{u.X, u.Y, u.Z, -Dot3(u, eye)},
+		{-f.X, -f.Y, -f.Z, Dot3(f, eye)},
+		{0, 0, 0, 1},
+	}
+}
+
+// Perspective returns a perspective projection with vertical field of view
+// fovy in radians, width to height ratio aspect, and near and far clip
+// distances.
+func Perspective(fovy, aspect, near, far float64) Mat4 {
+	f := 1 / math.Tan(fovy/2)
+	return Mat4{
+		{f / aspect, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, (far + near) / (near - far), 2 * far * near / (near - far)},
+		{0, 0, -1, 0},
+	}
+}
+
+// Frustum returns a perspective projection of the view volume whose near
+// plane spans [left, right] × [bottom, top] at distance near.
+func Frustum(left, right, bottom, top, near, far float64) Mat4 {
+	w, h, d := right-left, top-bottom, far-near
+	return Mat4{
+		{2 * near / w, 0, (right + left) / w, 0},
+		{0, 2 * near / h, (top + bottom) / h, 0},
+		{0, 0, -(far + near) / d, -2 * far * near / d},
+		{0, 0, -1, 0},
+	}
+}
+
+// Orthographic returns an orthographic projection of the box
+// [left, right] × [bottom, top] × [-far, -near] in view space.
+func Orthographic(left, right, bottom, top, near, far float64) Mat4 {
+	w, h, d := right-left, top-bottom, far-near
+	return Mat4{
+		{2 / w, 0, 0, -(right + left) / w},
+		{0, 2 / h, 0, -(top + bottom) / h},
+		{0, 0, -2 / d, -(far + near) / d},
+		{0, 0, 0, 1},
+	}
+}
+
+// Project maps the world point p to the screen through the combined
+// projection and view matrix viewProj. X and Y of the result are the screen
+// position within viewport, with Y growing downward from viewport.Min.Y;
+// Z is the depth in [0, 1] from the near to the far plane.
+func Project(p Vec3, viewProj Mat4, viewport Rect) Vec3 {
+	c := viewProj.MulVec(p.Vec4(1))
+	ndc := c.Vec3().Divs(c.W)
+	s := NDCToPixel(Vec2{ndc.X, ndc.Y}, viewport, true)
+	return Vec3{s.X, s.Y, (ndc.Z + 1) / 2}
+}
+
+// Unproject is the inverse of Project: it returns the world point shown at
+// screen position s.X, s.Y at depth s.Z. Returns false if viewProj is
+// singular.
+func Unproject(s Vec3, viewProj Mat4, viewport Rect) (Vec3, bool) {
+	inv, ok := viewProj.Inverse()
+	if !ok {
+		return Vec3{}, false
+	}
+	return unprojectInverse(s, inv, viewport), true
+}
+
+// ScreenPointToRay returns the ray from the near plane through the scene
+// shown at screen position s, as for picking objects under the mouse.
+// invViewProj is the inverse of the matrix passed to Project. Dir is a unit
+// vector, so distances along the ray are world distances.
+func ScreenPointToRay(s Vec2, viewport Rect, invViewProj Mat4) Ray3 {
+	near := unprojectInverse(Vec3{s.X, s.Y, 0}, invViewProj, viewport)
+	far := unprojectInverse(Vec3{s.X, s.Y, 1}, invViewProj, viewport)
+	return Ray3{near, Normalize3(far.Sub(near))}
+}
+
+// unprojectInverse maps screen position s.X, s.Y at depth s.Z back to world
+// space through inv, the inverse of the view-projection matrix.
+func unprojectInverse(s Vec3, inv Mat4, viewport Rect) Vec3 {
+	ndc := PixelToNDC(Vec2{s.X, s.Y}, viewport, true)
+	w := inv.MulVec(Vec4{ndc.X, ndc.Y, 2*s.Z - 1, 1})
+	return w.Vec3().Divs(w.W)
+}
@@ -0,0 +1,167 @@
+package vec
+
+import (
+	"fmt"
+	"math"
+)
+
+// Quat is a quaternion W + Xi + Yj + Zk. Unit quaternions represent 3D
+// rotations; rotating by q*r applies r first and then q.
+type Quat struct{ X, Y, Z, W float64 }
+
+// IdentityQuat returns the quaternion of no rotation.
+func IdentityQuat() Quat { return Quat{W: 1} }
+
+// QuatFromAxisAngle returns the rotation by angle radians counter-clockwise
+// around axis, following the right-hand rule. axis need not be normalized.
+func QuatFromAxisAngle(axis Vec3, angle float64) Quat {
+	sin, cos := math.Sincos(angle / 2)
+	a := Normalize3(axis).Muls(sin)
+	return Quat{a.X, a.Y, a.Z, cos}
+}
+
+// QuatFromMat3 returns the rotation represented by the orthonormal matrix m.
+func QuatFromMat3(m Mat3) Quat {
+	var q Quat
+	switch tr := m[0][0] + m[1][1] + m[2][2]; {
+	case tr > 0:
+		s := 2 * math.Sqrt(tr+1)
+		q = Quat{(m[2][1] - m[1][2]) / s, (m[0][2] - m[2][0]) / s, (m[1][0] - m[0][1]) / s, s / 4}
+	case m[0][0] > m[1][1] && m[0][0] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[0][0]-m[1][1]-m[2][2])
+		q = Quat{s / 4, (m[0][1] + m[1][0]) / s, (m[0][2] + m[2][0]) / s, (m[2][1] - m[1][2]) / s}
+	case m[1][1] > m[2][2]:
+		s := 2 * math.Sqrt(1+m[1][1]-m[0][0]-m[2][2])
+		q = Quat{(m[0][1] + m[1][0]) / s, s / 4, (m[1][2] + m[2][1]) / s, (m[0][2] - m[2][0]) / s}
+	default:
+		s := 2 * math.Sqrt(1+m[2][2]-m[0][0]-m[1][1])
+		q = Quat{(m[0][2] + m[2][0]) / s, (m[1][2] + m[2][1]) / s, s / 4, (m[1][0] - m[0][1]) / s}
+	}
+	return q.Normalize()
+}
+
+// Vec returns the vector part X, Y, Z of q.
+func (q Quat) Vec() Vec3 { return Vec3{q.X, q.Y, q.Z} }
+
+// Mul returns the Hamilton product q*r.
+func (q Quat) Mul(r Quat) Quat {
+	return Quat{
+		q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+		q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+	}
+}
+
+// Scale returns q with every component multiplied by k.
+func (q Quat) Scale(k float64) Quat { return Quat{q.X * k, q.Y * k, q.Z * k, q.W * k} }
+
+// Add returns the component-wise sum q+r.
+func (q Quat) Add(r Quat) Quat { return Quat{q.X + r.X, q.Y + r.Y, q.Z + r.Z, q.W + r.W} }
+
+// Conj returns the conjugate of q, which is its inverse if q is a unit quaternion.
+func (q Quat) Conj() Quat { return Quat{-q.X, -q.Y, -q.Z, q.W} }
+
+// Dot returns the 4D dot product of q and r.
+func (q Quat) Dot(r Quat) float64 { return q.X*r.X + q.Y*r.Y + q.Z*r.Z + q.W*r.W }
+
+// Len returns the norm of q.
+func (q Quat) Len() float64 { return math.Sqrt(q.Dot(q)) }
+
+// Normalize returns q scaled to unit length, or the identity if q is zero.
+func (q Quat) Normalize() Quat {
+	l := q.Len()
+	if l == 0 {
+		return IdentityQuat()
+	}
+	return q.Scale(1 / l)
+}
+
+// Inverse returns the multiplicative inverse of q.
+// It returns the zero quaternion if q is zero.
+func (q Quat) Inverse() Quat {
+	d := q.Dot(q)
+	if d == 0 {
+		return Quat{}
+	}
+	return q.Conj().Scale(1 / d)
+}
+
+// Rotate returns v rotated by the unit quaternion q.
+func (q Quat) Rotate(v Vec3) Vec3 {
+	u := q.Vec()
+	t := Cross3(u, v).Muls(2)
+	return v.Add(t.Muls(q.W)).Add(Cross3(u, t))
+}
+
+// AxisAngle returns the axis and angle in [0, π] of the rotation by the unit
+// quaternion q. The axis is +X for the identity rotation.
+func (q Quat) AxisAngle() (axis Vec3, angle float64) {
+	if q.W < 0 {
+		q = q.Scale(-1)
+	}
+	s := Len3(q.Vec())
+	if s == 0 {
+		return Vec3{1, 0, 0}, 0
+	}
+	return q.Vec().Divs(s), 2 * math.Atan2(s, q.W)
+}
+
+// Mat3 returns the rotation matrix of the unit quaternion q.
+func (q Quat) Mat3() Mat3 {
+	x, y, z, w := q.X, q.Y, q.Z, q.W
+	return Mat3{
+		{1 - 2*(y*y+z*z), 2 * (x*y - z*w), 2 * (x*z + y*w)},
+		{2 * (x*y + z*w), 1 - 2*(x*x+z*z), 2 * (y*z - x*w)},
+		{2 * (x*z - y*w), 2 * (y*z + x*w), 1 - 2*(x*x+y*y)},
+	}
+}
+
+// Mat4 returns the rotation matrix of the unit quaternion q as a homogeneous transform.
+func (q Quat) Mat4() Mat4 {
+	r := q.Mat3()
+	return Mat4{
+		{r[0][0], r[0][1], r[0][2], 0},
+		{r[1][0], r[1][1], r[1][2], 0},
+		{r[2][0], r[2][1], r[2][2], 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// SlerpQuat spherically interpolates between the unit quaternions a and b by
+// t, taking the shorter path.
+func SlerpQuat(a, b Quat, t float64) Quat {
+	dot := a.Dot(b)
+	if dot < 0 {
+		b, dot = b.Scale(-1), -dot
+	}
+	if dot > 0.9995 {
+		// Quaternions are very close, use normalized linear interpolation
+		return a.Scale(1 - t).Add(b.Scale(t)).Normalize()
+	}
+	theta := math.Acos(dot)
+	sinTheta := math.Sin(theta)
+	return a.Scale(math.Sin((1-t)*theta) / sinTheta).Add(b.Scale(math.Sin(t*theta) / sinTheta))
+}
+
+// Format implements fmt.Formatter, printing q as "(x, y, z, w)" with the
+// same verbs as the vector types.
+func (q Quat) Format(f fmt.State, verb rune) {
+	formatVec(f, verb, q, []float64{q.X, q.Y, q.Z, q.W})
+}
+
+// SwingTwist splits the unit quaternion q into a twist around axis and a
+// swing around an axis perpendicular to it, such that q = swing*twist.
+// Joint limits can then clamp the two independently. If q swings by half a
+// turn the twist is ambiguous and is returned as the identity.
+func SwingTwist(q Quat, axis Vec3) (swing, twist Quat) {
+	u := Normalize3(axis)
+	p := u.Muls(Dot3(q.Vec(), u))
+	twist = Quat{p.X, p.Y, p.Z, q.W}
+	if twist.Dot(twist) < 1e-18 {
+		twist = IdentityQuat()
+	} else {
+		twist = twist.Normalize()
+	}
+	return q.Mul(twist.Conj()), twist
+}
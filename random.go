@@ -0,0 +1,122 @@
+package vec
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// RandInTriangle2 returns a uniformly distributed random point inside the triangle abc.
+func RandInTriangle2(a, b, c Vec2, r *rand.Rand) Vec2 {
+	u, v := r.Float64(), r.Float64()
+	if u+v > 1 {
+		u, v = 1-u, 1-v
+	}
+	return a.Add(b.Sub(a).Scale(u)).Add(c.Sub(a).Scale(v))
+}
+
+// RandInTriangle3 returns a uniformly distributed random point inside the triangle abc.
+func RandInTriangle3(a, b, c Vec3, r *rand.Rand) Vec3 {
+	u, v := r.Float64(), r.Float64()
+	if u+v > 1 {
+		u, v = 1-u, 1-v
+	}
+	return a.Add(b.Sub(a).Scale(u)).Add(c.Sub(a).Scale(v))
+}
+
+// RandInPolygon returns a uniformly distributed random point inside poly.
+// The polygon is triangulated on every call, so callers sampling many points
+// from a large polygon may prefer to triangulate once and use RandInTriangle2.
+// Returns zero vector if poly has zero area.
+func RandInPolygon(poly Polygon, r *rand.Rand) Vec2 {
+	tris := poly.Triangulate()
+	areas := make([]float64, len(tris))
+	for i, t := range tris {
+		areas[i] = Polygon{poly[t[0]], poly[t[1]], poly[t[2]]}.Area()
+	}
+	i := pickWeighted(areas, r)
+	if i < 0 {
+		return Vec2{}
+	}
+	t := tris[i]
+	return RandInTriangle2(poly[t[0]], poly[t[1]], poly[t[2]], r)
+}
+
+// RandInRect returns a uniformly distributed random point inside rect.
+func RandInRect(rect Rect, r *rand.Rand) Vec2 {
+	return rect.Min.Add(rect.Size().Mul(Vec2{r.Float64(), r.Float64()}))
+}
+
+// RandInCircle returns a uniformly distributed random point inside c.
+func RandInCircle(c Circle, r *rand.Rand) Vec2 {
+	return PointOnCircle(c.Center, c.Radius*math.Sqrt(r.Float64()), 2*math.Pi*r.Float64())
+}
+
+// RandInShape returns a uniformly distributed random point inside shape.
+// Rects, circles and polygons are sampled directly; other shapes by drawing
+// points from their bounds until one lands inside, which takes few draws
+// for shapes that fill most of their bounds but many for thin diagonal
+// ones. Returns false if shape has no area or no point lands inside after
+// 1000 draws.
+func RandInShape(shape Shape2, r *rand.Rand) (Vec2, bool) {
+	switch s := shape.(type) {
+	case Rect:
+		sz := s.Size()
+		return RandInRect(s, r), sz.X > 0 && sz.Y > 0
+	case Circle:
+		return RandInCircle(s, r), s.Radius > 0
+	case Polygon:
+		if s.Area() == 0 {
+			return Vec2{}, false
+		}
+		return RandInPolygon(s, r), true
+	}
+	b := shape.Bounds()
+	for range 1000 {
+		if p := RandInRect(b, r); shape.ContainsPoint(p) {
+			return p, true
+		}
+	}
+	return Vec2{}, false
+}
+
+// RandOnMesh returns a uniformly distributed random point on the surface of
+// mesh, together with the unit normal of the triangle it lies on.
+// Normals follow the counter-clockwise winding of the triangles.
+// Returns zero vectors if mesh has zero surface area.
+func RandOnMesh(mesh Mesh, r *rand.Rand) (p, normal Vec3) {
+	n := mesh.NumTriangles()
+	areas := make([]float64, n)
+	for i := range n {
+		a, b, c := mesh.Triangle(i)
+		areas[i] = Len3(Cross3(b.Sub(a), c.Sub(a))) / 2
+	}
+	i := pickWeighted(areas, r)
+	if i < 0 {
+		return Vec3{}, Vec3{}
+	}
+	a, b, c := mesh.Triangle(i)
+	return RandInTriangle3(a, b, c, r), Normalize3(Cross3(b.Sub(a), c.Sub(a)))
+}
+
+// pickWeighted returns a random index into weights with probability
+// proportional to its weight, or -1 if all weights are zero.
+// The weights are replaced by their running sum.
+func pickWeighted(weights []float64, r *rand.Rand) int {
+	var total float64
+	for i, w := range weights {
+		total += w
+		weights[i] = total
+	}
+	if total <= 0 {
+		return -1
+	}
+	target := r.Float64() * total
+	i := sort.Search(len(weights), func(i int) bool { return weights[i] > target })
+	return min(i, len(weights)-1)
+}
+
+// RandOnEdge returns a uniformly distributed random point on the boundary of shape.
+func RandOnEdge(shape Shape2, r *rand.Rand) Vec2 {
+	return shape.EdgeAt(r.Float64())
+}
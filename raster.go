@@ -0,0 +1,134 @@
+package vec
+
+import "iter"
+
+// LinePoints returns an iterator over the cells of the Bresenham line from
+// a to b, including both ends. Consecutive cells touch by an edge or corner.
+func LinePoints(a, b Vec2i) iter.Seq[Vec2i] {
+	return func(yield func(Vec2i) bool) {
+		dx, dy := absInt(b.X-a.X), -absInt(b.Y-a.Y)
+		sx, sy := signInt(b.X-a.X), signInt(b.Y-a.Y)
+		err := dx + dy
+		p := a
+		for {
+			if !yield(p) || p == b {
+				return
+			}
+			e2 := 2 * err
+			if e2 >= dy {
+				err += dy
+				p.X += sx
+			}
+			if e2 <= dx {
+				err += dx
+				p.Y += sy
+			}
+		}
+	}
+}
+
+// SupercoverLinePoints returns an iterator over every cell touched by the
+// segment between the centers of cells a and b, including both ends.
+// Where the segment passes exactly through a cell corner, both cells
+// beside the corner are included before the diagonal one.
+func SupercoverLinePoints(a, b Vec2i) iter.Seq[Vec2i] {
+	return func(yield func(Vec2i) bool) {
+		nx, ny := absInt(b.X-a.X), absInt(b.Y-a.Y)
+		sx, sy := signInt(b.X-a.X), signInt(b.Y-a.Y)
+		p := a
+		if !yield(p) {
+			return
+		}
+		for ix, iy := 0, 0; ix < nx || iy < ny; {
+			// Compare the parameters at which the segment crosses the next
+			// vertical and horizontal cell boundaries.
+			switch d := (1+2*ix)*ny - (1+2*iy)*nx; {
+			case d == 0:
+				if !yield(Vec2i{p.X + sx, p.Y}) || !yield(Vec2i{p.X, p.Y + sy}) {
+					return
+				}
+				p.X += sx
+				p.Y += sy
+				ix++
+				iy++
+			case d < 0:
+				p.X += sx
+				ix++
+			default:
+				p.Y += sy
+				iy++
+			}
+			if !yield(p) {
+				return
+			}
+		}
+	}
+}
+
+// CirclePoints returns an iterator over the cells of the midpoint circle of
+// radius r around center, counter-clockwise from center+(r, 0), each once.
+func CirclePoints(center Vec2i, r int) iter.Seq[Vec2i] {
+	return func(yield func(Vec2i) bool) {
+		if r < 0 {
+			return
+		}
+		if r == 0 {
+			yield(center)
+			return
+		}
+
+		// One octant, from angle 0 to 45 degrees.
+		var oct []Vec2i
+		x, y, err := r, 0, 1-r
+		for y <= x {
+			oct = append(oct, Vec2i{x, y})
+			y++
+			if err < 0 {
+				err += 2*y + 1
+			} else {
+				x--
+				err += 2*(y-x) + 1
+			}
+		}
+
+		// Reflect it into the other octants in counter-clockwise order,
+		// reversing every other one and skipping shared endpoints.
+		maps := [8]func(Vec2i) Vec2i{
+			func(p Vec2i) Vec2i { return Vec2i{p.X, p.Y} },
+			func(p Vec2i) Vec2i { return Vec2i{p.Y, p.X} },
+			func(p Vec2i) Vec2i { return Vec2i{-p.Y, p.X} },
+			func(p Vec2i) Vec2i { return Vec2i{-p.X, p.Y} },
+			func(p Vec2i) Vec2i { return Vec2i{-p.X, -p.Y} },
+			func(p Vec2i) Vec2i { return Vec2i{-p.Y, -p.X} },
+			func(p Vec2i) Vec2i { return Vec2i{p.Y, -p.X} },
+			func(p Vec2i) Vec2i { return Vec2i{p.X, -p.Y} },
+		}
+		first := oct[0]
+		last := Vec2i{r + 1, 0} // not on the circle
+		for i, m := range maps {
+			for j := range oct {
+				if i%2 == 1 {
+					j = len(oct) - 1 - j
+				}
+				q := m(oct[j])
+				if q == last || (i == 7 && q == first) {
+					continue
+				}
+				last = q
+				if !yield(center.Add(q)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func signInt(x int) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,150 @@
+package vec
+
+// Reduce2 folds the components of a 2D vector into an accumulator,
+// starting from init and applying f to each component in order.
+func Reduce2[V Vec2like[S], S Scalar, T any](v V, init T, f func(T, S) T) T {
+	va := Vec2g[S](v)
+	return f(f(init, va.X), va.Y)
+}
+
+// Reduce3 folds the components of a 3D vector into an accumulator,
+// starting from init and applying f to each component in order.
+func Reduce3[V Vec3like[S], S Scalar, T any](v V, init T, f func(T, S) T) T {
+	va := Vec3g[S](v)
+	return f(f(f(init, va.X), va.Y), va.Z)
+}
+
+// Reduce4 folds the components of a 4D vector into an accumulator,
+// starting from init and applying f to each component in order.
+func Reduce4[V Vec4like[S], S Scalar, T any](v V, init T, f func(T, S) T) T {
+	va := Vec4g[S](v)
+	return f(f(f(f(init, va.X), va.Y), va.Z), va.W)
+}
+
+// ComponentSum2 returns the sum of the components of a 2D vector.
+func ComponentSum2[V Vec2like[S], S Scalar](v V) S {
+	va := Vec2g[S](v)
+	return va.X + va.Y
+}
+
+// ComponentSum3 returns the sum of the components of a 3D vector.
+func ComponentSum3[V Vec3like[S], S Scalar](v V) S {
+	va := Vec3g[S](v)
+	return va.X + va.Y + va.Z
+}
+
+// ComponentSum4 returns the sum of the components of a 4D vector.
+func ComponentSum4[V Vec4like[S], S Scalar](v V) S {
+	va := Vec4g[S](v)
+	return va.X + va.Y + va.Z + va.W
+}
+
+// ComponentProduct2 returns the product of the components of a 2D vector,
+// such as the area of a rectangle of that size.
+func ComponentProduct2[V Vec2like[S], S Scalar](v V) S {
+	va := Vec2g[S](v)
+	return va.X * va.Y
+}
+
+// ComponentProduct3 returns the product of the components of a 3D vector,
+// such as the volume of a box of that size.
+func ComponentProduct3[V Vec3like[S], S Scalar](v V) S {
+	va := Vec3g[S](v)
+	return va.X * va.Y * va.Z
+}
+
+// ComponentProduct4 returns the product of the components of a 4D vector.
+func ComponentProduct4[V Vec4like[S], S Scalar](v V) S {
+	va := Vec4g[S](v)
+	return va.X * va.Y * va.Z * va.W
+}
+
+// MinComponent2 returns the smallest component of a 2D vector.
+func MinComponent2[V Vec2like[S], S Scalar](v V) S {
+	va := Vec2g[S](v)
+	return min(va.X, va.Y)
+}
+
+// MinComponent3 returns the smallest component of a 3D vector.
+func MinComponent3[V Vec3like[S], S Scalar](v V) S {
+	va := Vec3g[S](v)
+	return min(va.X, va.Y, va.Z)
+}
+
+// MinComponent4 returns the smallest component of a 4D vector.
+func MinComponent4[V Vec4like[S], S Scalar](v V) S {
+	va := Vec4g[S](v)
+	return min(va.X, va.Y, va.Z, va.W)
+}
+
+// MaxComponent2 returns the largest component of a 2D vector.
+func MaxComponent2[V Vec2like[S], S Scalar](v V) S {
+	va := Vec2g[S](v)
+	return max(va.X, va.Y)
+}
+
+// MaxComponent3 returns the largest component of a 3D vector.
+func MaxComponent3[V Vec3like[S], S Scalar](v V) S {
+	va := Vec3g[S](v)
+	return max(va.X, va.Y, va.Z)
+}
+
+// MaxComponent4 returns the largest component of a 4D vector.
+func MaxComponent4[V Vec4like[S], S Scalar](v V) S {
+	va := Vec4g[S](v)
+	return max(va.X, va.Y, va.Z, va.W)
+}
+
+// ArgMin2 returns the index of the smallest component of a 2D vector,
+// the first one on ties. See At for the indices.
+func ArgMin2[V Vec2like[S], S Scalar](v V) int {
+	va := Vec2g[S](v)
+	return argExtreme([]S{va.X, va.Y}, func(a, b S) bool { return a < b })
+}
+
+// ArgMin3 returns the index of the smallest component of a 3D vector,
+// the first one on ties. See At for the indices.
+func ArgMin3[V Vec3like[S], S Scalar](v V) int {
+	va := Vec3g[S](v)
+	return argExtreme([]S{va.X, va.Y, va.Z}, func(a, b S) bool { return a < b })
+}
+
+// ArgMin4 returns the index of the smallest component of a 4D vector,
+// the first one on ties. See At for the indices.
+func ArgMin4[V Vec4like[S], S Scalar](v V) int {
+	va := Vec4g[S](v)
+	return argExtreme([]S{va.X, va.Y, va.Z, va.W}, func(a, b S) bool { return a < b })
+}
+
+// ArgMax2 returns the index of the largest component of a 2D vector,
+// the first one on ties. See At for the indices.
+func ArgMax2[V Vec2like[S], S Scalar](v V) int {
+	va := Vec2g[S](v)
+	return argExtreme([]S{va.X, va.Y}, func(a, b S) bool { return a > b })
+}
+
+// ArgMax3 returns the index of the largest component of a 3D vector,
+// the first one on ties. See At for the indices.
+func ArgMax3[V Vec3like[S], S Scalar](v V) int {
+	va := Vec3g[S](v)
+	return argExtreme([]S{va.X, va.Y, va.Z}, func(a, b S) bool { return a > b })
+}
+
+// ArgMax4 returns the index of the largest component of a 4D vector,
+// the first one on ties. See At for the indices.
+func ArgMax4[V Vec4like[S], S Scalar](v V) int {
+	va := Vec4g[S](v)
+	return argExtreme([]S{va.X, va.Y, va.Z, va.W}, func(a, b S) bool { return a > b })
+}
+
+// argExtreme returns the index of the first element of cs that no other
+// element beats.
+func argExtreme[S Scalar](cs []S, beats func(a, b S) bool) int {
+	best := 0
+	for i, c := range cs {
+		if beats(c, cs[best]) {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,183 @@
+package vec
+
+import "math"
+
+// RoundedRect is the rectangle Rect with its corners rounded off by arcs of
+// Radius. Radius is limited to half the shorter side of Rect.
+type RoundedRect struct {
+	Rect   Rect
+	Radius float64
+}
+
+// Capsule2 is the set of points within Radius of the segment from A to B,
+// a rectangle capped with half circles, as used for character colliders.
+type Capsule2 struct {
+	A, B   Vec2
+	Radius float64
+}
+
+// RoundedRect
+// ---
+
+// piece returns r as the rectangle shrunk by the radius and inflated back.
+func (r RoundedRect) piece() convexPiece {
+	s := r.Rect.Size()
+	rad := min(r.Radius, s.X/2, s.Y/2)
+	min, max := r.Rect.Min.Adds(rad), r.Rect.Max.Subs(rad)
+	return newRoundedPiece(Polygon{min, {max.X, min.Y}, max, {min.X, max.Y}}, rad)
+}
+
+// Perimeter returns the length of the boundary of r.
+func (r RoundedRect) Perimeter() float64 { return r.piece().perimeter() }
+
+// EdgeAt returns the point on the boundary of r at arc-length fraction t.
+// The boundary starts where the bottom edge, along Min.Y, leaves the
+// rounding at its Min.X end and runs counter-clockwise.
+func (r RoundedRect) EdgeAt(t float64) Vec2 { return r.piece().edgeAt(t) }
+
+// Translate returns r moved by d.
+func (r RoundedRect) Translate(d Vec2) RoundedRect { return RoundedRect{r.Rect.Translate(d), r.Radius} }
+
+// Bounds returns the smallest rectangle containing r.
+func (r RoundedRect) Bounds() Rect { return r.Rect }
+
+// Project returns the range of Dot2(p, axis) over the points p of r.
+func (r RoundedRect) Project(axis Vec2) (min, max float64) { return r.piece().project(axis) }
+
+// ContainsPoint reports whether p lies inside r or on its boundary.
+func (r RoundedRect) ContainsPoint(p Vec2) bool { return r.piece().containsPoint(p) }
+
+// ClosestPoint returns the point of r nearest to p, which is p itself if
+// p lies inside r.
+func (r RoundedRect) ClosestPoint(p Vec2) Vec2 { return r.piece().closestPoint(p) }
+
+// IntersectsCircle reports whether r and c overlap or touch.
+func (r RoundedRect) IntersectsCircle(c Circle) bool { return r.piece().intersectsCircle(c) }
+
+// IntersectsSegment reports whether s touches r.
+func (r RoundedRect) IntersectsSegment(s Segment2) bool { return r.piece().intersectsSegment(s) }
+
+// Capsule2
+// ---
+
+func (c Capsule2) piece() convexPiece { return newRoundedPiece(Polygon{c.A, c.B}, c.Radius) }
+
+// Perimeter returns the length of the boundary of c.
+func (c Capsule2) Perimeter() float64 { return c.piece().perimeter() }
+
+// EdgeAt returns the point on the boundary of c at arc-length fraction t.
+// The boundary starts beside A, on the right of the direction from A to B,
+// and runs counter-clockwise.
+func (c Capsule2) EdgeAt(t float64) Vec2 { return c.piece().edgeAt(t) }
+
+// Translate returns c moved by d.
+func (c Capsule2) Translate(d Vec2) Capsule2 { return Capsule2{c.A.Add(d), c.B.Add(d), c.Radius} }
+
+// Bounds returns the smallest rectangle containing c.
+func (c Capsule2) Bounds() Rect {
+	var b Bounds2
+	b.Add(c.A)
+	b.Add(c.B)
+	r := b.Result()
+	return Rect{r.Min.Subs(c.Radius), r.Max.Adds(c.Radius)}
+}
+
+// Project returns the range of Dot2(p, axis) over the points p of c.
+func (c Capsule2) Project(axis Vec2) (min, max float64) { return c.piece().project(axis) }
+
+// ContainsPoint reports whether p lies inside c or on its boundary.
+func (c Capsule2) ContainsPoint(p Vec2) bool { return c.piece().containsPoint(p) }
+
+// ClosestPoint returns the point of c nearest to p, which is p itself if
+// p lies inside c.
+func (c Capsule2) ClosestPoint(p Vec2) Vec2 { return c.piece().closestPoint(p) }
+
+// IntersectsCircle reports whether c and ci overlap or touch.
+func (c Capsule2) IntersectsCircle(ci Circle) bool { return c.piece().intersectsCircle(ci) }
+
+// IntersectsSegment reports whether s touches c.
+func (c Capsule2) IntersectsSegment(s Segment2) bool { return c.piece().intersectsSegment(s) }
+
+// Rounded pieces
+// ---
+
+// newRoundedPiece returns the convex polygon core inflated by r, dropping
+// repeated vertices so that a degenerate core becomes a segment or a point.
+func newRoundedPiece(core Polygon, r float64) convexPiece {
+	var pts Polygon
+	for i, v := range core {
+		if i == 0 || v != pts[len(pts)-1] {
+			pts = append(pts, v)
+		}
+	}
+	if len(pts) > 1 && pts[0] == pts[len(pts)-1] {
+		pts = pts[:len(pts)-1]
+	}
+	return convexPiece{pts, r}
+}
+
+// distance returns the signed distance from p to the boundary of c and the
+// outward normal there.
+func (c convexPiece) distance(p Vec2) (float64, Vec2) {
+	return roundedConvexDistance(c.points.Translate(p.Neg()), c.radius)
+}
+
+func (c convexPiece) containsPoint(p Vec2) bool {
+	d, _ := c.distance(p)
+	return d <= 0
+}
+
+func (c convexPiece) closestPoint(p Vec2) Vec2 {
+	d, n := c.distance(p)
+	if d <= 0 {
+		return p
+	}
+	return p.Sub(n.Scale(d))
+}
+
+func (c convexPiece) intersectsCircle(ci Circle) bool {
+	d, _ := c.distance(ci.Center)
+	return d <= ci.Radius
+}
+
+func (c convexPiece) intersectsSegment(s Segment2) bool {
+	hit, _, _ := rayRoundedConvex(s.B.Sub(s.A), 1, c.points.Translate(s.A.Neg()), c.radius)
+	return hit
+}
+
+func (c convexPiece) project(axis Vec2) (lo, hi float64) {
+	lo, hi = projectPoints(c.points, axis)
+	r := c.radius * Len2(axis)
+	return lo - r, hi + r
+}
+
+func (c convexPiece) perimeter() float64 { return c.points.Perimeter() + 2*math.Pi*c.radius }
+
+// edgeAt walks the boundary of c counter-clockwise, along each core edge
+// pushed out by the radius and then around the arc at its end vertex.
+func (c convexPiece) edgeAt(t float64) Vec2 {
+	pts := c.points
+	if len(pts) == 1 {
+		return Circle{pts[0], c.radius}.EdgeAt(t)
+	}
+	d := t * c.perimeter()
+	for i, a := range pts {
+		b := pts[(i+1)%len(pts)]
+		n0 := edgeNormal(b.Sub(a))
+		n1 := edgeNormal(pts[(i+2)%len(pts)].Sub(b))
+		l := Len2(b.Sub(a))
+		if d <= l {
+			return Lerp2(a, b, d/l).Add(n0.Scale(c.radius))
+		}
+		d -= l
+		turn := math.Mod(Angle2(n1)-Angle2(n0)+2*math.Pi, 2*math.Pi)
+		if arc := turn * c.radius; d <= arc || i == len(pts)-1 {
+			if c.radius == 0 {
+				return b
+			}
+			return b.Add(Rotate2(n0, min(d/c.radius, turn)).Scale(c.radius))
+		}
+		d -= turn * c.radius
+	}
+	return pts[0]
+}
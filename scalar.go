@@ -0,0 +1,119 @@
+package vec
+
+import "math"
+
+// SmoothMin returns a smooth approximation of min(a, b).
+// Within distance k of each other the values are blended by a quadratic,
+// so the result never exceeds min(a, b) and differs from it by at most k/4.
+// If k <= 0, it returns min(a, b).
+func SmoothMin[S Float](a, b, k S) S {
+	if k <= 0 {
+		return min(a, b)
+	}
+	h := max(k-abs(a-b), 0) / k
+	return min(a, b) - h*h*k/4
+}
+
+// SmoothMax returns a smooth approximation of max(a, b).
+// It is the mirror image of SmoothMin.
+func SmoothMax[S Float](a, b, k S) S { return -SmoothMin(-a, -b, k) }
+
+// SoftClamp limits v to [lo, hi] like a clamp, but eases into the bounds
+// exponentially over the last softness units instead of stopping abruptly.
+// Values well inside the range are unchanged, and the result is
+// continuously differentiable. softness is capped at half the range.
+func SoftClamp[S Float](v, lo, hi, softness S) S {
+	s := min(softness, (hi-lo)/2)
+	if s <= 0 {
+		return min(max(v, lo), hi)
+	}
+	switch {
+	case v > hi-s:
+		return hi - s*S(math.Exp(float64((hi-s-v)/s)))
+	case v < lo+s:
+		return lo + s*S(math.Exp(float64((v-lo-s)/s)))
+	}
+	return v
+}
+
+func abs[S Float](x S) S {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// SmoothMin2 applies SmoothMin to each component of a and b.
+func SmoothMin2[V Vec2like[S], S Scalar](a, b V, k float64) V {
+	va := As2[float64, S](a)
+	vb := As2[float64, S](b)
+	return V(NewAs2[S](SmoothMin(va.X, vb.X, k), SmoothMin(va.Y, vb.Y, k)))
+}
+
+// SmoothMin3 applies SmoothMin to each component of a and b.
+func SmoothMin3[V Vec3like[S], S Scalar](a, b V, k float64) V {
+	va := As3[float64, S](a)
+	vb := As3[float64, S](b)
+	return V(NewAs3[S](SmoothMin(va.X, vb.X, k), SmoothMin(va.Y, vb.Y, k), SmoothMin(va.Z, vb.Z, k)))
+}
+
+// SmoothMin4 applies SmoothMin to each component of a and b.
+func SmoothMin4[V Vec4like[S], S Scalar](a, b V, k float64) V {
+	va := As4[float64, S](a)
+	vb := As4[float64, S](b)
+	return V(NewAs4[S](
+		SmoothMin(va.X, vb.X, k), SmoothMin(va.Y, vb.Y, k),
+		SmoothMin(va.Z, vb.Z, k), SmoothMin(va.W, vb.W, k),
+	))
+}
+
+// SmoothMax2 applies SmoothMax to each component of a and b.
+func SmoothMax2[V Vec2like[S], S Scalar](a, b V, k float64) V {
+	va := As2[float64, S](a)
+	vb := As2[float64, S](b)
+	return V(NewAs2[S](SmoothMax(va.X, vb.X, k), SmoothMax(va.Y, vb.Y, k)))
+}
+
+// SmoothMax3 applies SmoothMax to each component of a and b.
+func SmoothMax3[V Vec3like[S], S Scalar](a, b V, k float64) V {
+	va := As3[float64, S](a)
+	vb := As3[float64, S](b)
+	return V(NewAs3[S](SmoothMax(va.X, vb.X, k), SmoothMax(va.Y, vb.Y, k), SmoothMax(va.Z, vb.Z, k)))
+}
+
+// SmoothMax4 applies SmoothMax to each component of a and b.
+func SmoothMax4[V Vec4like[S], S Scalar](a, b V, k float64) V {
+	va := As4[float64, S](a)
+	vb := As4[float64, S](b)
+	return V(NewAs4[S](
+		SmoothMax(va.X, vb.X, k), SmoothMax(va.Y, vb.Y, k),
+		SmoothMax(va.Z, vb.Z, k), SmoothMax(va.W, vb.W, k),
+	))
+}
+
+// SoftClamp2 applies SoftClamp to each component of v with bounds lo and hi.
+func SoftClamp2[V Vec2like[S], S Scalar](v, lo, hi V, softness float64) V {
+	vv, l, h := As2[float64, S](v), As2[float64, S](lo), As2[float64, S](hi)
+	return V(NewAs2[S](SoftClamp(vv.X, l.X, h.X, softness), SoftClamp(vv.Y, l.Y, h.Y, softness)))
+}
+
+// SoftClamp3 applies SoftClamp to each component of v with bounds lo and hi.
+func SoftClamp3[V Vec3like[S], S Scalar](v, lo, hi V, softness float64) V {
+	vv, l, h := As3[float64, S](v), As3[float64, S](lo), As3[float64, S](hi)
+	return V(NewAs3[S](
+		SoftClamp(vv.X, l.X, h.X, softness),
+		SoftClamp(vv.Y, l.Y, h.Y, softness),
+		SoftClamp(vv.Z, l.Z, h.Z, softness),
+	))
+}
+
+// SoftClamp4 applies SoftClamp to each component of v with bounds lo and hi.
+func SoftClamp4[V Vec4like[S], S Scalar](v, lo, hi V, softness float64) V {
+	vv, l, h := As4[float64, S](v), As4[float64, S](lo), As4[float64, S](hi)
+	return V(NewAs4[S](
+		SoftClamp(vv.X, l.X, h.X, softness),
+		SoftClamp(vv.Y, l.Y, h.Y, softness),
+		SoftClamp(vv.Z, l.Z, h.Z, softness),
+		SoftClamp(vv.W, l.W, h.W, softness),
+	))
+}
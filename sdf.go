@@ -0,0 +1,30 @@
+package vec
+
+// sdfEpsilon is the distance from a signed distance field's surface within
+// which Raymarch counts a hit, and the step EstimateNormal samples at.
+const sdfEpsilon = 1e-4
+
+// EstimateNormal returns the unit outward normal at p of the surface of
+// the signed distance field sdf, negative inside and positive outside, from
+// its gradient. p should lie on or near the surface.
+func EstimateNormal(sdf func(Vec3) float64, p Vec3) Vec3 {
+	return Normalize3(Gradient3(sdf, p, sdfEpsilon))
+}
+
+// Raymarch returns the first point where r meets the surface of the signed
+// distance field sdf, and its distance from r.Origin, by sphere tracing:
+// stepping along r by the distance to the surface, which cannot overshoot
+// it. Returns false if no hit is found within maxSteps steps, such as when
+// r escapes to infinity. sdf must not overestimate distances.
+func Raymarch(sdf func(Vec3) float64, r Ray3, maxSteps int) (p Vec3, t float64, ok bool) {
+	dir := Normalize3(r.Dir)
+	for range maxSteps {
+		p = r.Origin.Add(dir.Scale(t))
+		d := sdf(p)
+		if d < sdfEpsilon {
+			return p, t, true
+		}
+		t += d
+	}
+	return Vec3{}, 0, false
+}
@@ -0,0 +1,200 @@
+package vec
+
+import "math"
+
+// Shape2 is a closed 2D shape with a measurable boundary.
+type Shape2 interface {
+	// Perimeter returns the length of the boundary.
+	Perimeter() float64
+	// EdgeAt returns the point on the boundary at arc-length fraction t in [0, 1].
+	EdgeAt(t float64) Vec2
+	// Bounds returns the smallest axis-aligned rectangle containing the shape.
+	Bounds() Rect
+	// ContainsPoint reports whether p lies inside the shape or on its boundary.
+	ContainsPoint(p Vec2) bool
+	// ClosestPoint returns the point of the shape nearest to p, which is p
+	// itself if p lies inside.
+	ClosestPoint(p Vec2) Vec2
+	// Project returns the range of Dot2(p, axis) over the points p of the shape.
+	Project(axis Vec2) (min, max float64)
+}
+
+type (
+	// Rectg is an axis-aligned rectangle spanning from Min to Max.
+	Rectg[S Scalar] struct{ Min, Max Vec2g[S] }
+
+	Rect  = Rectg[float64]
+	Recti = Rectg[int]
+
+	// Boxg is an axis-aligned box spanning from Min to Max.
+	Boxg[S Scalar] struct{ Min, Max Vec3g[S] }
+
+	Box  = Boxg[float64]
+	Boxi = Boxg[int]
+)
+
+// Circle is a circle with the given center and radius.
+type Circle struct {
+	Center Vec2
+	Radius float64
+}
+
+// SampleEdge returns the point on the boundary of shape at arc-length fraction t.
+// t wraps around, so values outside [0, 1) continue along the boundary.
+func SampleEdge(shape Shape2, t float64) Vec2 {
+	return shape.EdgeAt(t - math.Floor(t))
+}
+
+// EdgePoints returns n points evenly spaced by arc length along the boundary of shape.
+func EdgePoints(shape Shape2, n int) []Vec2 {
+	ps := make([]Vec2, n)
+	for i := range ps {
+		ps[i] = shape.EdgeAt(float64(i) / float64(n))
+	}
+	return ps
+}
+
+// Rect
+// ---
+
+// Size returns the width and height of r.
+func (r Rectg[S]) Size() Vec2g[S] { return r.Max.Sub(r.Min) }
+
+// Perimeter returns the length of the boundary of r.
+func (r Rectg[S]) Perimeter() float64 {
+	s := r.Size()
+	return 2 * (float64(s.X) + float64(s.Y))
+}
+
+// EdgeAt returns the point on the boundary of r at arc-length fraction t.
+// The boundary starts at Min and runs counter-clockwise.
+func (r Rectg[S]) EdgeAt(t float64) Vec2 {
+	min, max := As2[float64](r.Min), As2[float64](r.Max)
+	w, h := max.X-min.X, max.Y-min.Y
+	d := t * 2 * (w + h)
+	switch {
+	case d <= w:
+		return Vec2{min.X + d, min.Y}
+	case d <= w+h:
+		return Vec2{max.X, min.Y + d - w}
+	case d <= 2*w+h:
+		return Vec2{max.X - (d - w - h), max.Y}
+	default:
+		return Vec2{min.X, max.Y - (d - 2*w - h)}
+	}
+}
+
+// Bounds returns r as a Rect.
+func (r Rectg[S]) Bounds() Rect { return Rect{As2[float64](r.Min), As2[float64](r.Max)} }
+
+// ContainsPoint reports whether p lies inside r or on its boundary.
+func (r Rectg[S]) ContainsPoint(p Vec2) bool {
+	b := r.Bounds()
+	return p.X >= b.Min.X && p.X <= b.Max.X && p.Y >= b.Min.Y && p.Y <= b.Max.Y
+}
+
+// ClosestPoint returns the point of r nearest to p, which is p itself if p
+// lies inside r.
+func (r Rectg[S]) ClosestPoint(p Vec2) Vec2 {
+	b := r.Bounds()
+	return Vec2{min(max(p.X, b.Min.X), b.Max.X), min(max(p.Y, b.Min.Y), b.Max.Y)}
+}
+
+// Project returns the range of Dot2(p, axis) over the points p of r.
+func (r Rectg[S]) Project(axis Vec2) (min, max float64) {
+	b := r.Bounds()
+	return projectPoints([]Vec2{b.Min, {b.Max.X, b.Min.Y}, b.Max, {b.Min.X, b.Max.Y}}, axis)
+}
+
+// Circle
+// ---
+
+// Perimeter returns the circumference of c.
+func (c Circle) Perimeter() float64 { return 2 * math.Pi * c.Radius }
+
+// EdgeAt returns the point on the boundary of c at arc-length fraction t.
+// The boundary starts on the +X axis and runs counter-clockwise.
+func (c Circle) EdgeAt(t float64) Vec2 {
+	sin, cos := math.Sincos(2 * math.Pi * t)
+	return Vec2{c.Center.X + c.Radius*cos, c.Center.Y + c.Radius*sin}
+}
+
+// Bounds returns the smallest rectangle containing c.
+func (c Circle) Bounds() Rect { return Rect{c.Center.Subs(c.Radius), c.Center.Adds(c.Radius)} }
+
+// ContainsPoint reports whether p lies inside c or on its boundary.
+func (c Circle) ContainsPoint(p Vec2) bool { return LenSq2(p.Sub(c.Center)) <= c.Radius*c.Radius }
+
+// ClosestPoint returns the point of c nearest to p, which is p itself if p
+// lies inside c.
+func (c Circle) ClosestPoint(p Vec2) Vec2 {
+	d := p.Sub(c.Center)
+	if l := Len2(d); l > c.Radius {
+		return c.Center.Add(d.Scale(c.Radius / l))
+	}
+	return p
+}
+
+// Project returns the range of Dot2(p, axis) over the points p of c.
+func (c Circle) Project(axis Vec2) (min, max float64) {
+	m, r := Dot2(c.Center, axis), c.Radius*Len2(axis)
+	return m - r, m + r
+}
+
+// Translate returns r moved by d.
+func (r Rectg[S]) Translate(d Vec2g[S]) Rectg[S] { return Rectg[S]{r.Min.Add(d), r.Max.Add(d)} }
+
+// Translate returns c moved by d.
+func (c Circle) Translate(d Vec2) Circle { return Circle{c.Center.Add(d), c.Radius} }
+
+// Segment
+// ---
+
+// Perimeter returns twice the length of s, as its boundary runs from A to B
+// and back.
+func (s Segment2) Perimeter() float64 { return 2 * Len2(s.B.Sub(s.A)) }
+
+// EdgeAt returns the point on the boundary of s at arc-length fraction t,
+// running from A at 0 to B at 0.5 and back to A at 1.
+func (s Segment2) EdgeAt(t float64) Vec2 { return Lerp2(s.A, s.B, 1-math.Abs(1-2*t)) }
+
+// Bounds returns the smallest rectangle containing s.
+func (s Segment2) Bounds() Rect {
+	var b Bounds2
+	b.Add(s.A)
+	b.Add(s.B)
+	return b.Result()
+}
+
+// ContainsPoint reports whether p lies on s.
+func (s Segment2) ContainsPoint(p Vec2) bool { return s.ClosestPoint(p) == p }
+
+// ClosestPoint returns the point of s nearest to p.
+func (s Segment2) ClosestPoint(p Vec2) Vec2 { return closestOnSegment(p, s.A, s.B) }
+
+// Project returns the range of Dot2(p, axis) over the points p of s.
+func (s Segment2) Project(axis Vec2) (min, max float64) {
+	return projectPoints([]Vec2{s.A, s.B}, axis)
+}
+
+// Translate returns s moved by d.
+func (s Segment2) Translate(d Vec2) Segment2 { return Segment2{s.A.Add(d), s.B.Add(d)} }
+
+// Box
+// ---
+
+// Size returns the width, height and depth of b.
+func (b Boxg[S]) Size() Vec3g[S] { return b.Max.Sub(b.Min) }
+
+// Translate returns b moved by d.
+func (b Boxg[S]) Translate(d Vec3g[S]) Boxg[S] { return Boxg[S]{b.Min.Add(d), b.Max.Add(d)} }
+
+// projectPoints returns the range of Dot2(p, axis) over points.
+func projectPoints(points []Vec2, axis Vec2) (lo, hi float64) {
+	lo, hi = math.Inf(1), math.Inf(-1)
+	for _, p := range points {
+		d := Dot2(p, axis)
+		lo, hi = min(lo, d), max(hi, d)
+	}
+	return lo, hi
+}
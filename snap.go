@@ -0,0 +1,50 @@
+package vec
+
+import "math"
+
+// Snap2 rounds each component of v to the nearest multiple of step.
+func Snap2[V Vec2like[S], S Scalar](v V, step float64) V {
+	va := Vec2g[S](v)
+	return V(Vec2g[S]{snap(va.X, step), snap(va.Y, step)})
+}
+
+// Snap3 rounds each component of v to the nearest multiple of step.
+func Snap3[V Vec3like[S], S Scalar](v V, step float64) V {
+	va := Vec3g[S](v)
+	return V(Vec3g[S]{snap(va.X, step), snap(va.Y, step), snap(va.Z, step)})
+}
+
+// Quantize2 rounds each component of v to the nearest multiple of the
+// corresponding component of step. Axes with a zero step are unchanged.
+func Quantize2[V1, V2 Vec2like[S], S Scalar](v V1, step V2) V1 {
+	va, vs := Vec2g[S](v), Vec2g[S](step)
+	return V1(Vec2g[S]{snap(va.X, float64(vs.X)), snap(va.Y, float64(vs.Y))})
+}
+
+// Quantize3 rounds each component of v to the nearest multiple of the
+// corresponding component of step. Axes with a zero step are unchanged.
+func Quantize3[V1, V2 Vec3like[S], S Scalar](v V1, step V2) V1 {
+	va, vs := Vec3g[S](v), Vec3g[S](step)
+	return V1(Vec3g[S]{snap(va.X, float64(vs.X)), snap(va.Y, float64(vs.Y)), snap(va.Z, float64(vs.Z))})
+}
+
+// SnapToGrid returns the cell containing p in a grid of cells of the given
+// size whose cell (0, 0) has its minimum corner at origin. Points on a
+// boundary belong to the cell on their positive side.
+func SnapToGrid(p, cellSize, origin Vec2) Vec2i {
+	q := p.Sub(origin).Div(cellSize)
+	return Vec2i{int(math.Floor(q.X)), int(math.Floor(q.Y))}
+}
+
+// CellOrigin returns the minimum corner of the cell in a grid laid out as
+// for SnapToGrid.
+func CellOrigin(cell Vec2i, cellSize, origin Vec2) Vec2 {
+	return As2[float64](cell).Mul(cellSize).Add(origin)
+}
+
+func snap[S Scalar](x S, step float64) S {
+	if step == 0 {
+		return x
+	}
+	return S(math.Round(float64(x)/step) * step)
+}
@@ -0,0 +1,135 @@
+package vec
+
+import "math"
+
+// singularTolerance is the fraction of the product of the row lengths of a
+// matrix below which Solve2x2 and Solve3x3 treat its determinant as zero.
+// That product bounds the determinant, and reaches it when the rows are
+// perpendicular.
+const singularTolerance = 1e-12
+
+// Solve2x2 returns x such that m*x = b, by Cramer's rule.
+// Returns false if m is singular or nearly so, with rows so close to
+// parallel that rounding would dominate the result.
+func Solve2x2(m Mat2, b Vec2) (Vec2, bool) {
+	d := m.Det()
+	bound := math.Hypot(m[0][0], m[0][1]) * math.Hypot(m[1][0], m[1][1])
+	if math.Abs(d) <= singularTolerance*bound {
+		return Vec2{}, false
+	}
+	return Vec2{
+		(b.X*m[1][1] - m[0][1]*b.Y) / d,
+		(m[0][0]*b.Y - b.X*m[1][0]) / d,
+	}, true
+}
+
+// Solve3x3 returns x such that m*x = b, by Cramer's rule.
+// Returns false if m is singular or nearly so, as for Solve2x2.
+func Solve3x3(m Mat3, b Vec3) (Vec3, bool) {
+	d := m.Det()
+	bound := 1.0
+	for _, row := range m {
+		bound *= math.Sqrt(row[0]*row[0] + row[1]*row[1] + row[2]*row[2])
+	}
+	if math.Abs(d) <= singularTolerance*bound {
+		return Vec3{}, false
+	}
+	var x Vec3
+	for j := range 3 {
+		mj := m
+		for i := range 3 {
+			mj[i][j] = b.At(i)
+		}
+		x.SetAt(j, mj.Det()/d)
+	}
+	return x, true
+}
+
+// LeastSquares2 returns x minimizing the sum of (Dot2(rows[i], x) - b[i])²,
+// the best fit to an overdetermined system of equations given one row per
+// equation. It solves the normal equations, which is accurate enough for
+// well-conditioned systems of a few unknowns.
+// Returns false if the rows do not span two dimensions.
+func LeastSquares2(rows []Vec2, b []float64) (Vec2, bool) {
+	if len(rows) != len(b) {
+		panic("vec: LeastSquares2 length mismatch")
+	}
+	var ata Mat2
+	var atb Vec2
+	for i, r := range rows {
+		for j := range 2 {
+			for k := range 2 {
+				ata[j][k] += r.At(j) * r.At(k)
+			}
+		}
+		atb = atb.Add(r.Scale(b[i]))
+	}
+	return Solve2x2(ata, atb)
+}
+
+// LeastSquares3 returns x minimizing the sum of (Dot3(rows[i], x) - b[i])²,
+// as LeastSquares2 does for three unknowns.
+// Returns false if the rows do not span three dimensions.
+func LeastSquares3(rows []Vec3, b []float64) (Vec3, bool) {
+	if len(rows) != len(b) {
+		panic("vec: LeastSquares3 length mismatch")
+	}
+	var ata Mat3
+	var atb Vec3
+	for i, r := range rows {
+		for j := range 3 {
+			for k := range 3 {
+				ata[j][k] += r.At(j) * r.At(k)
+			}
+		}
+		atb = atb.Add(r.Scale(b[i]))
+	}
+	return Solve3x3(ata, atb)
+}
+
+// pinvTolerance is the fraction of the largest eigenvalue of mᵀm below
+// which PseudoInverse treats an eigenvalue as zero.
+const pinvTolerance = 1e-12
+
+// PseudoInverse returns the Moore-Penrose pseudo-inverse of m, which is the
+// inverse if m is invertible. For singular m, m.PseudoInverse().MulVec(b) is
+// the shortest x minimizing the error of m*x = b.
+func (m Mat2) PseudoInverse() Mat2 {
+	// m⁺ = (mᵀm)⁺mᵀ, inverting mᵀm on its nonzero eigenvalues.
+	mt := m.Transpose()
+	vals, vecs := mt.Mul(m).EigenSym()
+	var inv Mat2
+	for k := range 2 {
+		l := vals.At(k)
+		if l <= pinvTolerance*vals.X {
+			continue
+		}
+		for i := range 2 {
+			for j := range 2 {
+				inv[i][j] += vecs[k].At(i) * vecs[k].At(j) / l
+			}
+		}
+	}
+	return inv.Mul(mt)
+}
+
+// PseudoInverse returns the Moore-Penrose pseudo-inverse of m, which is the
+// inverse if m is invertible. For singular m, m.PseudoInverse().MulVec(b) is
+// the shortest x minimizing the error of m*x = b.
+func (m Mat3) PseudoInverse() Mat3 {
+	mt := m.Transpose()
+	vals, vecs := mt.Mul(m).EigenSym()
+	var inv Mat3
+	for k := range 3 {
+		l := vals.At(k)
+		if l <= pinvTolerance*vals.X {
+			continue
+		}
+		for i := range 3 {
+			for j := range 3 {
+				inv[i][j] += vecs[k].At(i) * vecs[k].At(j) / l
+			}
+		}
+	}
+	return inv.Mul(mt)
+}
@@ -0,0 +1,50 @@
+package vec
+
+import "math"
+
+// goldenAngle is the angle that divides a full turn in the golden ratio.
+var goldenAngle = math.Pi * (3 - math.Sqrt(5))
+
+// SunflowerPoints returns n points evenly scattered over the disk of the
+// given radius around the origin, placed along a spiral that turns by the
+// golden angle at each point, like the seeds of a sunflower.
+func SunflowerPoints(n int, radius float64) []Vec2 {
+	ps := make([]Vec2, n)
+	for i := range ps {
+		r := radius * math.Sqrt((float64(i)+0.5)/float64(n))
+		ps[i] = PointOnCircle(Vec2{}, r, float64(i)*goldenAngle)
+	}
+	return ps
+}
+
+// ArchimedeanSpiral returns n points along the spiral that starts at the
+// origin, turns counter-clockwise, and moves spacing outward per turn.
+// Consecutive points are about step apart along the curve.
+func ArchimedeanSpiral(n int, spacing, step float64) []Vec2 {
+	b := spacing / (2 * math.Pi) // r = b*θ
+	ps := make([]Vec2, n)
+	var theta float64
+	for i := range ps {
+		r := b * theta
+		ps[i] = PointOnCircle(Vec2{}, r, theta)
+		// The arc length per radian is hypot(r, b).
+		if l := math.Hypot(r, b); l > 0 {
+			theta += step / l
+		}
+	}
+	return ps
+}
+
+// FibonacciSphere returns n unit vectors evenly spread over the sphere,
+// spiralling from +Z down to -Z by the golden angle, as for sample
+// directions or placing objects around a globe.
+func FibonacciSphere(n int) []Vec3 {
+	ps := make([]Vec3, n)
+	for i := range ps {
+		z := 1 - (2*float64(i)+1)/float64(n)
+		r := math.Sqrt(1 - z*z)
+		sin, cos := math.Sincos(float64(i) * goldenAngle)
+		ps[i] = Vec3{r * cos, r * sin, z}
+	}
+	return ps
+}
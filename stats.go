@@ -0,0 +1,167 @@
+package vec
+
+import "math"
+
+// Sum2 returns the component-wise sum of vs.
+func Sum2[V Vec2like[S], S Scalar](vs []V) V {
+	var s Vec2g[S]
+	for _, v := range vs {
+		s = s.Add(Vec2g[S](v))
+	}
+	return V(s)
+}
+
+// Sum3 returns the component-wise sum of vs.
+func Sum3[V Vec3like[S], S Scalar](vs []V) V {
+	var s Vec3g[S]
+	for _, v := range vs {
+		s = s.Add(Vec3g[S](v))
+	}
+	return V(s)
+}
+
+// Mean2 returns the arithmetic mean (centroid) of vs.
+// Returns zero vector if vs is empty.
+func Mean2[V Vec2like[S], S Scalar](vs []V) Vec2 {
+	var s Vec2
+	for _, v := range vs {
+		s = s.Add(As2[float64, S](v))
+	}
+	if len(vs) == 0 {
+		return s
+	}
+	return s.Divs(float64(len(vs)))
+}
+
+// Mean3 returns the arithmetic mean (centroid) of vs.
+// Returns zero vector if vs is empty.
+func Mean3[V Vec3like[S], S Scalar](vs []V) Vec3 {
+	var s Vec3
+	for _, v := range vs {
+		s = s.Add(As3[float64, S](v))
+	}
+	if len(vs) == 0 {
+		return s
+	}
+	return s.Divs(float64(len(vs)))
+}
+
+// WeightedMean2 returns the mean of vs where vs[i] has weight weights[i].
+// Returns zero vector if the weights sum to zero.
+func WeightedMean2[V Vec2like[S], S Scalar](vs []V, weights []float64) Vec2 {
+	var s Vec2
+	var total float64
+	for i, v := range vs {
+		s = s.Add(As2[float64, S](v).Scale(weights[i]))
+		total += weights[i]
+	}
+	if total == 0 {
+		return Vec2{}
+	}
+	return s.Divs(total)
+}
+
+// WeightedMean3 returns the mean of vs where vs[i] has weight weights[i].
+// Returns zero vector if the weights sum to zero.
+func WeightedMean3[V Vec3like[S], S Scalar](vs []V, weights []float64) Vec3 {
+	var s Vec3
+	var total float64
+	for i, v := range vs {
+		s = s.Add(As3[float64, S](v).Scale(weights[i]))
+		total += weights[i]
+	}
+	if total == 0 {
+		return Vec3{}
+	}
+	return s.Divs(total)
+}
+
+// Variance2 returns the per-axis population variance of vs.
+func Variance2[V Vec2like[S], S Scalar](vs []V) Vec2 {
+	c := Covariance2(vs)
+	return Vec2{c[0][0], c[1][1]}
+}
+
+// Variance3 returns the per-axis population variance of vs.
+func Variance3[V Vec3like[S], S Scalar](vs []V) Vec3 {
+	c := Covariance3(vs)
+	return Vec3{c[0][0], c[1][1], c[2][2]}
+}
+
+// StdDev2 returns the per-axis population standard deviation of vs.
+func StdDev2[V Vec2like[S], S Scalar](vs []V) Vec2 {
+	return Map2(Variance2(vs), math.Sqrt)
+}
+
+// StdDev3 returns the per-axis population standard deviation of vs.
+func StdDev3[V Vec3like[S], S Scalar](vs []V) Vec3 {
+	return Map3(Variance3(vs), math.Sqrt)
+}
+
+// Covariance2 returns the population covariance matrix of vs.
+// Returns zero matrix if vs is empty.
+func Covariance2[V Vec2like[S], S Scalar](vs []V) Mat2 {
+	var c Mat2
+	if len(vs) == 0 {
+		return c
+	}
+	mean := Mean2(vs)
+	for _, v := range vs {
+		d := ToArray2(As2[float64, S](v).Sub(mean))
+		for i := range 2 {
+			for j := range 2 {
+				c[i][j] += d[i] * d[j]
+			}
+		}
+	}
+	n := float64(len(vs))
+	for i := range 2 {
+		for j := range 2 {
+			c[i][j] /= n
+		}
+	}
+	return c
+}
+
+// Covariance3 returns the population covariance matrix of vs.
+// Returns zero matrix if vs is empty.
+func Covariance3[V Vec3like[S], S Scalar](vs []V) Mat3 {
+	var c Mat3
+	if len(vs) == 0 {
+		return c
+	}
+	mean := Mean3(vs)
+	for _, v := range vs {
+		d := ToArray3(As3[float64, S](v).Sub(mean))
+		for i := range 3 {
+			for j := range 3 {
+				c[i][j] += d[i] * d[j]
+			}
+		}
+	}
+	n := float64(len(vs))
+	for i := range 3 {
+		for j := range 3 {
+			c[i][j] /= n
+		}
+	}
+	return c
+}
+
+// PCA2 returns the principal axes of points as unit vectors, ordered by
+// decreasing variance, together with the variance along each axis.
+// The axes form a counter-clockwise basis.
+func PCA2[V Vec2like[S], S Scalar](points []V) (axes [2]Vec2, variances Vec2) {
+	variances, axes = Covariance2(points).EigenSym()
+	axes[1] = Vec2{-axes[0].Y, axes[0].X}
+	return axes, variances
+}
+
+// PCA3 returns the principal axes of points as unit vectors, ordered by
+// decreasing variance, together with the variance along each axis.
+// The axes form a right-handed basis.
+func PCA3[V Vec3like[S], S Scalar](points []V) (axes [3]Vec3, variances Vec3) {
+	variances, axes = Covariance3(points).EigenSym()
+	axes[2] = Cross3(axes[0], axes[1])
+	return axes, variances
+}
@@ -0,0 +1,209 @@
+package vec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// GPU uniform and storage buffers lay out data by the std140 and std430
+// rules, under which a vec3 is aligned to 16 bytes, so a Go struct of Vec3
+// fields does not match when uploaded as is. AppendStd140 and AppendStd430
+// apply the rules while encoding, and Vec3Std140 and Mat3Std140 pad by hand
+// for code that copies Go structs directly.
+
+// Vec3Std140 is a Vec3g[float32] followed by 4 bytes of padding, matching
+// the 16-byte stride of a vec3 in std140 and std430 arrays and matrices.
+type Vec3Std140 struct {
+	Vec3g[float32]
+	_ float32
+}
+
+// Mat3Std140 is a mat3 in std140 and std430 layout: three padded columns.
+type Mat3Std140 [3]Vec3Std140
+
+// PadVec3 returns v as a Vec3Std140, converting components to float32.
+func PadVec3[V Vec3like[S], S Scalar](v V) Vec3Std140 {
+	return Vec3Std140{Vec3g: As3[float32](v)}
+}
+
+// PadMat3 returns m as a Mat3Std140, converting the row-major m to the
+// column-major layout GLSL expects.
+func PadMat3(m Mat3) Mat3Std140 {
+	var p Mat3Std140
+	for j := range 3 {
+		p[j] = PadVec3(Vec3{m[0][j], m[1][j], m[2][j]})
+	}
+	return p
+}
+
+// AppendStd140 appends values to buf in std140 layout, as consecutive
+// members of a uniform block starting at the beginning of buf.
+//
+// Values may be scalars, vectors (structs of 2 to 4 numeric fields such as
+// Vec3), Mat2, Mat3, Mat4, Vec3Std140, Mat3Std140, and slices or arrays of
+// these. Floats are written as float32, signed integers as int32 and
+// unsigned integers and bools as uint32. Matrices are written column-major.
+// It panics on other types.
+func AppendStd140(buf []byte, values ...any) []byte {
+	for _, v := range values {
+		buf = appendStd(buf, reflect.ValueOf(v), false)
+	}
+	return buf
+}
+
+// AppendStd430 is like AppendStd140 but uses the std430 layout of storage
+// buffers, in which arrays of scalars and 2-component vectors are not
+// padded to 16-byte strides.
+func AppendStd430(buf []byte, values ...any) []byte {
+	for _, v := range values {
+		buf = appendStd(buf, reflect.ValueOf(v), true)
+	}
+	return buf
+}
+
+var vec3Std140Type = reflect.TypeFor[Vec3Std140]()
+
+// stdMatrixSize returns n if t is Mat2, Mat3 or Mat4, or zero otherwise.
+func stdMatrixSize(t reflect.Type) int {
+	switch t {
+	case reflect.TypeFor[Mat2]():
+		return 2
+	case reflect.TypeFor[Mat3]():
+		return 3
+	case reflect.TypeFor[Mat4]():
+		return 4
+	}
+	return 0
+}
+
+// stdLayout returns the base alignment and size of t.
+func stdLayout(t reflect.Type, std430 bool) (align, size int) {
+	if t == vec3Std140Type {
+		return 16, 16
+	}
+	if n := stdMatrixSize(t); n > 0 {
+		// A matrix is an array of n column vectors.
+		stride, align := stdStride(stdVecAlign(n), 4*n, std430)
+		return align, n * stride
+	}
+	switch {
+	case isStdScalar(t):
+		return 4, 4
+	case isStdVector(t):
+		return stdVecAlign(t.NumField()), 4 * t.NumField()
+	case t.Kind() == reflect.Array:
+		align, size := stdLayout(t.Elem(), std430)
+		stride, align := stdStride(align, size, std430)
+		return align, t.Len() * stride
+	}
+	panic(fmt.Sprintf("vec: unsupported type %v for std140/std430", t))
+}
+
+// stdVecAlign returns the base alignment of a vector of n components.
+func stdVecAlign(n int) int {
+	if n == 2 {
+		return 8
+	}
+	return 16
+}
+
+// stdStride returns the stride and alignment of an array whose elements
+// have the given alignment and size. std140 rounds both up to 16.
+func stdStride(align, size int, std430 bool) (stride, arrayAlign int) {
+	stride = alignUp(size, align)
+	if !std430 {
+		align = alignUp(align, 16)
+		stride = alignUp(stride, 16)
+	}
+	return stride, align
+}
+
+func appendStd(buf []byte, v reflect.Value, std430 bool) []byte {
+	t := v.Type()
+	if t == vec3Std140Type {
+		buf = padTo(buf, 16)
+		return append(appendStd(buf, v.Field(0), std430), 0, 0, 0, 0)
+	}
+	if n := stdMatrixSize(t); n > 0 {
+		stride, align := stdStride(stdVecAlign(n), 4*n, std430)
+		buf = padTo(buf, align)
+		for j := range n {
+			start := len(buf)
+			for i := range n {
+				buf = appendStdScalar(buf, v.Index(i).Index(j))
+			}
+			buf = append(buf, make([]byte, start+stride-len(buf))...)
+		}
+		return buf
+	}
+	switch {
+	case isStdScalar(t):
+		return appendStdScalar(padTo(buf, 4), v)
+	case isStdVector(t):
+		buf = padTo(buf, stdVecAlign(t.NumField()))
+		for i := range t.NumField() {
+			buf = appendStdScalar(buf, v.Field(i))
+		}
+		return buf
+	case t.Kind() == reflect.Array || t.Kind() == reflect.Slice:
+		align, size := stdLayout(t.Elem(), std430)
+		stride, align := stdStride(align, size, std430)
+		buf = padTo(buf, align)
+		for i := range v.Len() {
+			start := len(buf)
+			buf = appendStd(buf, v.Index(i), std430)
+			buf = append(buf, make([]byte, start+stride-len(buf))...)
+		}
+		return buf
+	}
+	panic(fmt.Sprintf("vec: unsupported type %v for std140/std430", t))
+}
+
+func isStdScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// isStdVector reports whether t is a struct of 2 to 4 scalar fields.
+func isStdVector(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.NumField() < 2 || t.NumField() > 4 {
+		return false
+	}
+	for i := range t.NumField() {
+		if !isStdScalar(t.Field(i).Type) {
+			return false
+		}
+	}
+	return true
+}
+
+func appendStdScalar(buf []byte, v reflect.Value) []byte {
+	var u uint32
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			u = 1
+		}
+	case reflect.Float32, reflect.Float64:
+		u = math.Float32bits(float32(v.Float()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		u = uint32(int32(v.Int()))
+	default:
+		u = uint32(v.Uint())
+	}
+	return binary.LittleEndian.AppendUint32(buf, u)
+}
+
+// padTo appends zeros to buf until its length is a multiple of align.
+func padTo(buf []byte, align int) []byte {
+	return append(buf, make([]byte, alignUp(len(buf), align)-len(buf))...)
+}
+
+func alignUp(n, align int) int { return (n + align - 1) / align * align }
@@ -0,0 +1,75 @@
+package vec
+
+import "math"
+
+// TangentPoints returns the points on c where lines through p touch it.
+// t1 is counter-clockwise from the direction of p as seen from c's center.
+// If p lies on the circle, both points equal p.
+// Returns false if p is inside c.
+func TangentPoints(p Vec2, c Circle) (t1, t2 Vec2, ok bool) {
+	d := p.Sub(c.Center)
+	dist := Len2(d)
+	if dist < c.Radius || dist == 0 {
+		return Vec2{}, Vec2{}, false
+	}
+	alpha := math.Acos(c.Radius / dist)
+	u := d.Scale(c.Radius / dist)
+	return c.Center.Add(Rotate2(u, alpha)), c.Center.Add(Rotate2(u, -alpha)), true
+}
+
+// TangentPointsFromPoint is TangentPoints with the circle given first.
+func TangentPointsFromPoint(c Circle, p Vec2) (t1, t2 Vec2, ok bool) { return TangentPoints(p, c) }
+
+// ExternalTangents returns the two tangent lines touching a and b on the same
+// side, as segments from the touching point on a to the one on b.
+// Returns false if one circle contains the other.
+func ExternalTangents(a, b Circle) (s1, s2 Segment2, ok bool) {
+	return circleTangents(a, b, 1)
+}
+
+// InternalTangents returns the two tangent lines crossing between a and b,
+// as segments from the touching point on a to the one on b.
+// Returns false if the circles overlap.
+func InternalTangents(a, b Circle) (s1, s2 Segment2, ok bool) {
+	return circleTangents(a, b, -1)
+}
+
+// circleTangents finds the tangents whose touching points lie along the unit
+// normal n from a's center and along sign*n from b's center.
+func circleTangents(a, b Circle, sign float64) (s1, s2 Segment2, ok bool) {
+	d := b.Center.Sub(a.Center)
+	dist := Len2(d)
+	if dist == 0 {
+		return Segment2{}, Segment2{}, false
+	}
+	k := (a.Radius - sign*b.Radius) / dist
+	if k > 1 || k < -1 {
+		return Segment2{}, Segment2{}, false
+	}
+	alpha := math.Acos(k)
+	u := d.Divs(dist)
+	tangent := func(n Vec2) Segment2 {
+		return Segment2{a.Center.Add(n.Scale(a.Radius)), b.Center.Add(n.Scale(sign * b.Radius))}
+	}
+	return tangent(Rotate2(u, alpha)), tangent(Rotate2(u, -alpha)), true
+}
+
+// TangentLinesBetweenCircles returns every line tangent to both a and b, as
+// segments from the touching point on a to the one on b: the external
+// tangents first, then the internal ones. There are four for separate
+// circles, three if they touch from outside, two if they overlap, one if
+// they touch from inside, and none if one contains the other.
+func TangentLinesBetweenCircles(a, b Circle) []Segment2 {
+	var ss []Segment2
+	for _, sign := range []float64{1, -1} {
+		s1, s2, ok := circleTangents(a, b, sign)
+		switch {
+		case !ok:
+		case s1 == s2:
+			ss = append(ss, s1)
+		default:
+			ss = append(ss, s1, s2)
+		}
+	}
+	return ss
+}
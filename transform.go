@@ -0,0 +1,236 @@
+package vec
+
+// Transform2 is a node in a 2D transform hierarchy. Its local transform
+// scales, then rotates, then translates, all relative to its parent. The
+// world transform is computed on demand and cached until the node or one
+// of its ancestors changes.
+//
+// The zero value is not ready for use; create nodes with NewTransform2.
+type Transform2 struct {
+	position Vec2
+	rotation float64
+	scale    Vec2
+
+	parent   *Transform2
+	children []*Transform2
+	world    Affine2
+	dirty    bool
+}
+
+// NewTransform2 returns a root node with the identity transform.
+func NewTransform2() *Transform2 {
+	return &Transform2{scale: Vec2{1, 1}, dirty: true}
+}
+
+// Position returns the translation of t relative to its parent.
+func (t *Transform2) Position() Vec2 { return t.position }
+
+// SetPosition sets the translation of t relative to its parent.
+func (t *Transform2) SetPosition(p Vec2) { t.position = p; t.invalidate() }
+
+// Rotation returns the counter-clockwise rotation of t in radians relative to its parent.
+func (t *Transform2) Rotation() float64 { return t.rotation }
+
+// SetRotation sets the counter-clockwise rotation of t in radians relative to its parent.
+func (t *Transform2) SetRotation(angle float64) { t.rotation = angle; t.invalidate() }
+
+// Scale returns the per-axis scale of t.
+func (t *Transform2) Scale() Vec2 { return t.scale }
+
+// SetScale sets the per-axis scale of t.
+func (t *Transform2) SetScale(s Vec2) { t.scale = s; t.invalidate() }
+
+// Parent returns the parent of t, or nil if t is a root.
+func (t *Transform2) Parent() *Transform2 { return t.parent }
+
+// Children returns the nodes whose parent is t. The slice must not be modified.
+func (t *Transform2) Children() []*Transform2 { return t.children }
+
+// SetParent moves t under parent, or makes it a root if parent is nil.
+// The local transform is kept, so the world transform changes.
+// It panics if parent is t or one of its descendants.
+func (t *Transform2) SetParent(parent *Transform2) {
+	for p := parent; p != nil; p = p.parent {
+		if p == t {
+			panic("vec: Transform2 parent cycle")
+		}
+	}
+	if t.parent != nil {
+		t.parent.children = removeChild(t.parent.children, t)
+	}
+	t.parent = parent
+	if parent != nil {
+		parent.children = append(parent.children, t)
+	}
+	t.invalidate()
+}
+
+// Local returns the transform from the space of t to the space of its parent.
+func (t *Transform2) Local() Affine2 {
+	return Translation2(t.position).Mul(Rotation2(t.rotation)).Mul(Scaling2(t.scale))
+}
+
+// World returns the transform from the space of t to world space.
+func (t *Transform2) World() Affine2 {
+	if t.dirty {
+		t.world = t.Local()
+		if t.parent != nil {
+			t.world = t.parent.World().Mul(t.world)
+		}
+		t.dirty = false
+	}
+	return t.world
+}
+
+// WorldPosition returns the origin of t in world space.
+func (t *Transform2) WorldPosition() Vec2 { return t.World().Offset() }
+
+// LocalToWorld returns the point p, given in the space of t, in world space.
+func (t *Transform2) LocalToWorld(p Vec2) Vec2 { return t.World().Apply(p) }
+
+// WorldToLocal returns the world point p in the space of t.
+// It returns the zero vector if the world transform is singular.
+func (t *Transform2) WorldToLocal(p Vec2) Vec2 {
+	inv, _ := t.World().Inverse()
+	return inv.Apply(p)
+}
+
+// invalidate marks the cached world transforms of t and its descendants as
+// stale. A stale node always has stale descendants, so the walk stops at
+// nodes that are already stale.
+func (t *Transform2) invalidate() {
+	if t.dirty {
+		return
+	}
+	t.dirty = true
+	for _, c := range t.children {
+		c.invalidate()
+	}
+}
+
+// Transform3 is a node in a 3D transform hierarchy. Its local transform
+// scales, then rotates, then translates, all relative to its parent. The
+// world transform is computed on demand and cached until the node or one
+// of its ancestors changes.
+//
+// The zero value is not ready for use; create nodes with NewTransform3.
+type Transform3 struct {
+	position Vec3
+	rotation Quat
+	scale    Vec3
+
+	parent   *Transform3
+	children []*Transform3
+	world    Mat4
+	dirty    bool
+}
+
+// NewTransform3 returns a root node with the identity transform.
+func NewTransform3() *Transform3 {
+	return &Transform3{rotation: IdentityQuat(), scale: Vec3{1, 1, 1}, dirty: true}
+}
+
+// Position returns the translation of t relative to its parent.
+func (t *Transform3) Position() Vec3 { return t.position }
+
+// SetPosition sets the translation of t relative to its parent.
+func (t *Transform3) SetPosition(p Vec3) { t.position = p; t.invalidate() }
+
+// Rotation returns the rotation of t relative to its parent.
+func (t *Transform3) Rotation() Quat { return t.rotation }
+
+// SetRotation sets the rotation of t relative to its parent. q must be a unit quaternion.
+func (t *Transform3) SetRotation(q Quat) { t.rotation = q; t.invalidate() }
+
+// Scale returns the per-axis scale of t.
+func (t *Transform3) Scale() Vec3 { return t.scale }
+
+// SetScale sets the per-axis scale of t.
+func (t *Transform3) SetScale(s Vec3) { t.scale = s; t.invalidate() }
+
+// Parent returns the parent of t, or nil if t is a root.
+func (t *Transform3) Parent() *Transform3 { return t.parent }
+
+// Children returns the nodes whose parent is t. The slice must not be modified.
+func (t *Transform3) Children() []*Transform3 { return t.children }
+
+// SetParent moves t under parent, or makes it a root if parent is nil.
+// The local transform is kept, so the world transform changes.
+// It panics if parent is t or one of its descendants.
+func (t *Transform3) SetParent(parent *Transform3) {
+	for p := parent; p != nil; p = p.parent {
+		if p == t {
+			panic("vec: Transform3 parent cycle")
+		}
+	}
+	if t.parent != nil {
+		t.parent.children = removeChild(t.parent.children, t)
+	}
+	t.parent = parent
+	if parent != nil {
+		parent.children = append(parent.children, t)
+	}
+	t.invalidate()
+}
+
+// Local returns the transform from the space of t to the space of its parent.
+func (t *Transform3) Local() Mat4 {
+	m := t.rotation.Mat4()
+	for i := range 3 {
+		m[i][0] *= t.scale.X
+		m[i][1] *= t.scale.Y
+		m[i][2] *= t.scale.Z
+	}
+	m[0][3], m[1][3], m[2][3] = t.position.X, t.position.Y, t.position.Z
+	return m
+}
+
+// World returns the transform from the space of t to world space.
+func (t *Transform3) World() Mat4 {
+	if t.dirty {
+		t.world = t.Local()
+		if t.parent != nil {
+			t.world = t.parent.World().Mul(t.world)
+		}
+		t.dirty = false
+	}
+	return t.world
+}
+
+// WorldPosition returns the origin of t in world space.
+func (t *Transform3) WorldPosition() Vec3 {
+	w := t.World()
+	return Vec3{w[0][3], w[1][3], w[2][3]}
+}
+
+// LocalToWorld returns the point p, given in the space of t, in world space.
+func (t *Transform3) LocalToWorld(p Vec3) Vec3 { return t.World().MulVec(p.Vec4(1)).Vec3() }
+
+// WorldToLocal returns the world point p in the space of t.
+// It returns the zero vector if the world transform is singular.
+func (t *Transform3) WorldToLocal(p Vec3) Vec3 {
+	inv, _ := t.World().Inverse()
+	return inv.MulVec(p.Vec4(1)).Vec3()
+}
+
+// invalidate marks the cached world transforms of t and its descendants as
+// stale. A stale node always has stale descendants, so the walk stops at
+// nodes that are already stale.
+func (t *Transform3) invalidate() {
+	if t.dirty {
+		return
+	}
+	t.dirty = true
+	for _, c := range t.children {
+		c.invalidate()
+	}
+}
+
+func removeChild[T comparable](children []T, c T) []T {
+	for i, x := range children {
+		if x == c {
+			return append(children[:i], children[i+1:]...)
+		}
+	}
+	return children
+}
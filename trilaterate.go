@@ -0,0 +1,82 @@
+package vec
+
+import "math"
+
+// Trilaterate2 returns the point whose distances to anchors best match
+// distances in the least-squares sense, as for locating a listener from
+// the ranges to known beacons. At least three anchors not on one line are
+// needed. Returns false otherwise.
+//
+// Subtracting the equation of the first anchor from the others leaves a
+// linear system, so noisy distances are weighted a little unevenly but no
+// iteration or starting guess is needed. Anchors so nearly on one line
+// that the system is singular up to rounding are rejected too.
+func Trilaterate2(anchors []Vec2, distances []float64) (Vec2, bool) {
+	if len(anchors) != len(distances) {
+		panic("vec: Trilaterate2 length mismatch")
+	}
+	if len(anchors) < 3 {
+		return Vec2{}, false
+	}
+	// |x-a|² - |x-a0|² = d² - d0² is linear in x. Work relative to a0.
+	a0, d0 := anchors[0], distances[0]
+	rows := make([]Vec2, len(anchors)-1)
+	b := make([]float64, len(rows))
+	for i, a := range anchors[1:] {
+		r := a.Sub(a0)
+		rows[i] = r.Scale(2)
+		b[i] = LenSq2(r) + d0*d0 - distances[i+1]*distances[i+1]
+	}
+	x, ok := LeastSquares2(rows, b)
+	if !ok {
+		return Vec2{}, false
+	}
+	return x.Add(a0), true
+}
+
+// Trilaterate3 returns the point whose distances to anchors best match
+// distances, as Trilaterate2 does in 3D. At least four anchors not on one
+// plane are needed; three leave two mirror-image solutions.
+// Returns false otherwise.
+func Trilaterate3(anchors []Vec3, distances []float64) (Vec3, bool) {
+	if len(anchors) != len(distances) {
+		panic("vec: Trilaterate3 length mismatch")
+	}
+	if len(anchors) < 4 {
+		return Vec3{}, false
+	}
+	a0, d0 := anchors[0], distances[0]
+	rows := make([]Vec3, len(anchors)-1)
+	b := make([]float64, len(rows))
+	for i, a := range anchors[1:] {
+		r := a.Sub(a0)
+		rows[i] = r.Scale(2)
+		b[i] = LenSq3(r) + d0*d0 - distances[i+1]*distances[i+1]
+	}
+	x, ok := LeastSquares3(rows, b)
+	if !ok {
+		return Vec3{}, false
+	}
+	return x.Add(a0), true
+}
+
+// Triangulate2 returns the point nearest, in the least-squares sense, to
+// the lines of sight from observers at the given bearings, as for locating
+// a sound heard from several positions. Bearings are angles in radians
+// counter-clockwise from +X. At least two observers with different
+// bearings are needed. Returns false otherwise; nearly parallel bearings
+// instead give a distant point that is sensitive to noise.
+func Triangulate2(observers []Vec2, bearings []float64) (Vec2, bool) {
+	if len(observers) != len(bearings) {
+		panic("vec: Triangulate2 length mismatch")
+	}
+	// Each line of sight is Dot2(n, x) = Dot2(n, o) with n its normal.
+	rows := make([]Vec2, len(observers))
+	b := make([]float64, len(rows))
+	for i, o := range observers {
+		s, c := math.Sincos(bearings[i])
+		n := Vec2{-s, c}
+		rows[i], b[i] = n, Dot2(n, o)
+	}
+	return LeastSquares2(rows, b)
+}
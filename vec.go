@@ -1,6 +1,9 @@
 package vec
 
-import "math"
+import (
+	"math"
+	"unsafe"
+)
 
 // ====================
 // Types
@@ -200,6 +203,13 @@ func Dot4[V1, V2 Vec4like[S], S Scalar](a V1, b V2) S {
 func Lerp2[V1, V2 Vec2like[S], S Scalar](a V1, b V2, t float64) V1 {
 	va := Vec2g[S](a)
 	vb := Vec2g[S](b)
+	if isFloat32[S]() {
+		t := S(t)
+		return V1(Vec2g[S]{
+			X: va.X + (vb.X-va.X)*t,
+			Y: va.Y + (vb.Y-va.Y)*t,
+		})
+	}
 	return V1(Vec2g[S]{
 		X: S(float64(va.X) + (float64(vb.X)-float64(va.X))*t),
 		Y: S(float64(va.Y) + (float64(vb.Y)-float64(va.Y))*t),
@@ -210,6 +220,14 @@ func Lerp2[V1, V2 Vec2like[S], S Scalar](a V1, b V2, t float64) V1 {
 func Lerp3[V1, V2 Vec3like[S], S Scalar](a V1, b V2, t float64) V1 {
 	va := Vec3g[S](a)
 	vb := Vec3g[S](b)
+	if isFloat32[S]() {
+		t := S(t)
+		return V1(Vec3g[S]{
+			X: va.X + (vb.X-va.X)*t,
+			Y: va.Y + (vb.Y-va.Y)*t,
+			Z: va.Z + (vb.Z-va.Z)*t,
+		})
+	}
 	return V1(Vec3g[S]{
 		X: S(float64(va.X) + (float64(vb.X)-float64(va.X))*t),
 		Y: S(float64(va.Y) + (float64(vb.Y)-float64(va.Y))*t),
@@ -221,6 +239,15 @@ func Lerp3[V1, V2 Vec3like[S], S Scalar](a V1, b V2, t float64) V1 {
 func Lerp4[V1, V2 Vec4like[S], S Scalar](a V1, b V2, t float64) V1 {
 	va := Vec4g[S](a)
 	vb := Vec4g[S](b)
+	if isFloat32[S]() {
+		t := S(t)
+		return V1(Vec4g[S]{
+			X: va.X + (vb.X-va.X)*t,
+			Y: va.Y + (vb.Y-va.Y)*t,
+			Z: va.Z + (vb.Z-va.Z)*t,
+			W: va.W + (vb.W-va.W)*t,
+		})
+	}
 	return V1(Vec4g[S]{
 		X: S(float64(va.X) + (float64(vb.X)-float64(va.X))*t),
 		Y: S(float64(va.Y) + (float64(vb.Y)-float64(va.Y))*t),
@@ -342,10 +369,22 @@ func Slerp3[V1, V2 Vec3like[S], S Scalar](a V1, b V2, t float64) V1 {
 	})
 }
 
+// isFloat32 reports whether S is a 32-bit float type. Lerp, Normalize and
+// Rotate2 use it to compute in float32 directly rather than round-tripping
+// through float64.
+func isFloat32[S Scalar]() bool {
+	var z S
+	return unsafe.Sizeof(z) == 4 && isFloat[S]()
+}
+
 // Rotate2 rotates v by angle radians.
 func Rotate2[V Vec2like[S], S Scalar](v V, angle float64) V {
 	va := Vec2g[S](v)
 	sin, cos := math.Sincos(angle)
+	if isFloat32[S]() {
+		sin, cos := S(sin), S(cos)
+		return V(Vec2g[S]{va.X*cos - va.Y*sin, va.X*sin + va.Y*cos})
+	}
 	return V(Vec2g[S]{
 		X: S(float64(va.X)*cos - float64(va.Y)*sin),
 		Y: S(float64(va.X)*sin + float64(va.Y)*cos),
@@ -391,6 +430,42 @@ func Zip4[V1, V2 Vec4like[S], S Scalar](a V1, b V2, f func(S, S) S) V1 {
 	return V1(Vec4g[S]{f(va.X, vb.X), f(va.Y, vb.Y), f(va.Z, vb.Z), f(va.W, vb.W)})
 }
 
+// ZipWith2 applies f to corresponding components of three 2D vectors.
+func ZipWith2[V1, V2, V3 Vec2like[S], S Scalar](a V1, b V2, c V3, f func(S, S, S) S) V1 {
+	va, vb, vc := Vec2g[S](a), Vec2g[S](b), Vec2g[S](c)
+	return V1(Vec2g[S]{f(va.X, vb.X, vc.X), f(va.Y, vb.Y, vc.Y)})
+}
+
+// ZipWith3 applies f to corresponding components of three 3D vectors.
+func ZipWith3[V1, V2, V3 Vec3like[S], S Scalar](a V1, b V2, c V3, f func(S, S, S) S) V1 {
+	va, vb, vc := Vec3g[S](a), Vec3g[S](b), Vec3g[S](c)
+	return V1(Vec3g[S]{f(va.X, vb.X, vc.X), f(va.Y, vb.Y, vc.Y), f(va.Z, vb.Z, vc.Z)})
+}
+
+// ZipWith4 applies f to corresponding components of three 4D vectors.
+func ZipWith4[V1, V2, V3 Vec4like[S], S Scalar](a V1, b V2, c V3, f func(S, S, S) S) V1 {
+	va, vb, vc := Vec4g[S](a), Vec4g[S](b), Vec4g[S](c)
+	return V1(Vec4g[S]{f(va.X, vb.X, vc.X), f(va.Y, vb.Y, vc.Y), f(va.Z, vb.Z, vc.Z), f(va.W, vb.W, vc.W)})
+}
+
+// MulAdd2 returns the component-wise a*b + c.
+func MulAdd2[V1, V2, V3 Vec2like[S], S Scalar](a V1, b V2, c V3) V1 {
+	va, vb, vc := Vec2g[S](a), Vec2g[S](b), Vec2g[S](c)
+	return V1(Vec2g[S]{va.X*vb.X + vc.X, va.Y*vb.Y + vc.Y})
+}
+
+// MulAdd3 returns the component-wise a*b + c.
+func MulAdd3[V1, V2, V3 Vec3like[S], S Scalar](a V1, b V2, c V3) V1 {
+	va, vb, vc := Vec3g[S](a), Vec3g[S](b), Vec3g[S](c)
+	return V1(Vec3g[S]{va.X*vb.X + vc.X, va.Y*vb.Y + vc.Y, va.Z*vb.Z + vc.Z})
+}
+
+// MulAdd4 returns the component-wise a*b + c.
+func MulAdd4[V1, V2, V3 Vec4like[S], S Scalar](a V1, b V2, c V3) V1 {
+	va, vb, vc := Vec4g[S](a), Vec4g[S](b), Vec4g[S](c)
+	return V1(Vec4g[S]{va.X*vb.X + vc.X, va.Y*vb.Y + vc.Y, va.Z*vb.Z + vc.Z, va.W*vb.W + vc.W})
+}
+
 // Apply2 transforms all components of a 2D vector at once.
 func Apply2[V Vec2like[S], S Scalar](v V, f func(S, S) (S, S)) V {
 	va := Vec2g[S](v)
@@ -458,6 +533,13 @@ func Angle2[V Vec2like[S], S Scalar](v V) float64 {
 // Returns zero vector if the input has zero length.
 func Normalize2[V Vec2like[S], S Scalar](v V) V {
 	va := Vec2g[S](v)
+	if isFloat32[S]() {
+		l := S(math.Sqrt(float64(LenSq2(va))))
+		if l == 0 {
+			return V(Vec2g[S]{})
+		}
+		return V(Vec2g[S]{va.X / l, va.Y / l})
+	}
 	l := Len2(va)
 	if l == 0 {
 		return V(Vec2g[S]{0, 0})
@@ -469,6 +551,13 @@ func Normalize2[V Vec2like[S], S Scalar](v V) V {
 // Returns zero vector if the input has zero length.
 func Normalize3[V Vec3like[S], S Scalar](v V) V {
 	va := Vec3g[S](v)
+	if isFloat32[S]() {
+		l := S(math.Sqrt(float64(LenSq3(va))))
+		if l == 0 {
+			return V(Vec3g[S]{})
+		}
+		return V(Vec3g[S]{va.X / l, va.Y / l, va.Z / l})
+	}
 	l := Len3(va)
 	if l == 0 {
 		return V(Vec3g[S]{0, 0, 0})
@@ -480,6 +569,13 @@ func Normalize3[V Vec3like[S], S Scalar](v V) V {
 // Returns zero vector if the input has zero length.
 func Normalize4[V Vec4like[S], S Scalar](v V) V {
 	va := Vec4g[S](v)
+	if isFloat32[S]() {
+		l := S(math.Sqrt(float64(LenSq4(va))))
+		if l == 0 {
+			return V(Vec4g[S]{})
+		}
+		return V(Vec4g[S]{va.X / l, va.Y / l, va.Z / l, va.W / l})
+	}
 	l := Len4(va)
 	if l == 0 {
 		return V(Vec4g[S]{0, 0, 0, 0})
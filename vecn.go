@@ -0,0 +1,186 @@
+package vec
+
+import (
+	"fmt"
+	"math"
+)
+
+// VecN is a vector of any dimension backed by a slice, for math beyond four
+// dimensions such as feature vectors or configuration spaces. It offers the
+// same operations as the fixed-size vectors; methods and functions taking
+// two VecN panic if their lengths differ. Results are newly allocated and
+// never alias the operands.
+type VecN[S Scalar] []S
+
+// NewN creates an N-dimensional vector from its components.
+func NewN[S Scalar](cs ...S) VecN[S] { return append(VecN[S](nil), cs...) }
+
+// SplatN creates an n-dimensional vector with every component set to s.
+func SplatN[S Scalar](n int, s S) VecN[S] {
+	v := make(VecN[S], n)
+	for i := range v {
+		v[i] = s
+	}
+	return v
+}
+
+// AsN converts v to an N-dimensional vector with component type T.
+func AsN[T, S Scalar](v VecN[S]) VecN[T] {
+	r := make(VecN[T], len(v))
+	for i, c := range v {
+		r[i] = T(c)
+	}
+	return r
+}
+
+// Len returns the dimension of a.
+func (a VecN[S]) Len() int { return len(a) }
+
+// Add returns a+b.
+func (a VecN[S]) Add(b VecN[S]) VecN[S] { return ZipN(a, b, func(x, y S) S { return x + y }) }
+
+// Adds returns a with s added to each component.
+func (a VecN[S]) Adds(s S) VecN[S] { return MapN(a, func(x S) S { return x + s }) }
+
+// Sub returns a-b.
+func (a VecN[S]) Sub(b VecN[S]) VecN[S] { return ZipN(a, b, func(x, y S) S { return x - y }) }
+
+// Subs returns a with s subtracted from each component.
+func (a VecN[S]) Subs(s S) VecN[S] { return MapN(a, func(x S) S { return x - s }) }
+
+// Mul returns the component-wise product of a and b.
+func (a VecN[S]) Mul(b VecN[S]) VecN[S] { return ZipN(a, b, func(x, y S) S { return x * y }) }
+
+// Muls returns a with each component multiplied by s.
+func (a VecN[S]) Muls(s S) VecN[S] { return MapN(a, func(x S) S { return x * s }) }
+
+// Div returns the component-wise quotient of a and b.
+func (a VecN[S]) Div(b VecN[S]) VecN[S] { return ZipN(a, b, func(x, y S) S { return x / y }) }
+
+// Divs returns a with each component divided by s.
+func (a VecN[S]) Divs(s S) VecN[S] { return MapN(a, func(x S) S { return x / s }) }
+
+// Neg returns -a.
+func (a VecN[S]) Neg() VecN[S] { return MapN(a, func(x S) S { return -x }) }
+
+// Eq reports whether a and b have the same dimension and components.
+func (a VecN[S]) Eq(b VecN[S]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Eqs reports whether every component of a equals s.
+func (a VecN[S]) Eqs(s S) bool {
+	for _, c := range a {
+		if c != s {
+			return false
+		}
+	}
+	return true
+}
+
+// Scale returns a with each component multiplied by s.
+func (a VecN[S]) Scale(s S) VecN[S] { return a.Muls(s) }
+
+// String returns a formatted as "(x, y, ...)".
+func (a VecN[S]) String() string { return fmt.Sprint(a) }
+
+// Format implements fmt.Formatter with the same verbs as the fixed-size
+// vectors, except that %+v names components by index, as in "(0: 3, 1: 4)".
+func (a VecN[S]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%T{", a)
+		for i, c := range a {
+			if i > 0 {
+				fmt.Fprint(f, ", ")
+			}
+			fmt.Fprintf(f, "%#v", c)
+		}
+		fmt.Fprint(f, "}")
+		return
+	}
+	if verb == 'q' {
+		fmt.Fprintf(f, "%q", fmt.Sprint(a))
+		return
+	}
+	directive := fmt.FormatString(f, verb)
+	if verb == 's' {
+		directive = fmt.FormatString(f, 'v')
+	}
+	named := verb == 'v' && f.Flag('+')
+	fmt.Fprint(f, "(")
+	for i, c := range a {
+		if i > 0 {
+			fmt.Fprint(f, ", ")
+		}
+		if named {
+			fmt.Fprintf(f, "%d: %v", i, c)
+			continue
+		}
+		fmt.Fprintf(f, directive, c)
+	}
+	fmt.Fprint(f, ")")
+}
+
+// DotN returns the dot product of two N-dimensional vectors.
+func DotN[S Scalar](a, b VecN[S]) S {
+	checkLenN(a, b)
+	var d S
+	for i := range a {
+		d += a[i] * b[i]
+	}
+	return d
+}
+
+// LenSqN returns the squared length of an N-dimensional vector.
+func LenSqN[S Scalar](v VecN[S]) S { return DotN(v, v) }
+
+// LenN returns the length of an N-dimensional vector.
+func LenN[S Scalar](v VecN[S]) float64 { return math.Sqrt(float64(LenSqN(v))) }
+
+// NormalizeN returns the unit vector of an N-dimensional vector.
+// Returns zero vector if the input has zero length.
+func NormalizeN[S Scalar](v VecN[S]) VecN[S] {
+	l := LenN(v)
+	if l == 0 {
+		return make(VecN[S], len(v))
+	}
+	return MapN(v, func(x S) S { return S(float64(x) / l) })
+}
+
+// LerpN linearly interpolates between a and b by t.
+func LerpN[S Scalar](a, b VecN[S], t float64) VecN[S] {
+	return ZipN(a, b, func(x, y S) S { return S(float64(x) + (float64(y)-float64(x))*t) })
+}
+
+// MapN applies f to each component of an N-dimensional vector.
+func MapN[S Scalar](v VecN[S], f func(S) S) VecN[S] {
+	r := make(VecN[S], len(v))
+	for i, c := range v {
+		r[i] = f(c)
+	}
+	return r
+}
+
+// ZipN applies f to corresponding components of two N-dimensional vectors.
+func ZipN[S Scalar](a, b VecN[S], f func(S, S) S) VecN[S] {
+	checkLenN(a, b)
+	r := make(VecN[S], len(a))
+	for i := range a {
+		r[i] = f(a[i], b[i])
+	}
+	return r
+}
+
+func checkLenN[S Scalar](a, b VecN[S]) {
+	if len(a) != len(b) {
+		panic("vec: VecN length mismatch")
+	}
+}
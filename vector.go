@@ -0,0 +1,108 @@
+package vec
+
+import "math"
+
+// Vector is satisfied by Vec2g, Vec3g and Vec4g with component type S, so
+// that an algorithm can be written once for every dimension:
+//
+//	func Centroid[V vec.Vector[V, S], S vec.Scalar](a, b, c V) V {
+//		return a.Add(b).Add(c).Divs(3)
+//	}
+//
+// The functions below are the dimension-generic counterparts of the
+// numbered package functions such as Lerp2 and Lerp3. Type arguments are
+// inferred, so Lerp(a, b, t) works for any of the vector types.
+type Vector[V any, S Scalar] interface {
+	Vec2g[S] | Vec3g[S] | Vec4g[S]
+	Add(V) V
+	Adds(S) V
+	Sub(V) V
+	Subs(S) V
+	Mul(V) V
+	Muls(S) V
+	Div(V) V
+	Divs(S) V
+	Neg() V
+	Eq(V) bool
+	Eqs(S) bool
+	Scale(S) V
+}
+
+// Dot returns the dot product of a and b.
+func Dot[V Vector[V, S], S Scalar](a, b V) S {
+	switch a := any(a).(type) {
+	case Vec2g[S]:
+		return Dot2(a, any(b).(Vec2g[S]))
+	case Vec3g[S]:
+		return Dot3(a, any(b).(Vec3g[S]))
+	default:
+		return Dot4(a.(Vec4g[S]), any(b).(Vec4g[S]))
+	}
+}
+
+// LenSq returns the squared length of v.
+func LenSq[V Vector[V, S], S Scalar](v V) S { return Dot(v, v) }
+
+// Len returns the length of v.
+func Len[V Vector[V, S], S Scalar](v V) float64 { return math.Sqrt(float64(LenSq(v))) }
+
+// Distance returns the distance between a and b.
+func Distance[V Vector[V, S], S Scalar](a, b V) float64 { return Len(b.Sub(a)) }
+
+// DistanceSq returns the squared distance between a and b.
+func DistanceSq[V Vector[V, S], S Scalar](a, b V) S { return LenSq(b.Sub(a)) }
+
+// Normalize returns the unit vector of v.
+// Returns zero vector if the input has zero length.
+func Normalize[V Vector[V, S], S Scalar](v V) V {
+	switch v := any(v).(type) {
+	case Vec2g[S]:
+		return any(Normalize2(v)).(V)
+	case Vec3g[S]:
+		return any(Normalize3(v)).(V)
+	default:
+		return any(Normalize4(v.(Vec4g[S]))).(V)
+	}
+}
+
+// Lerp linearly interpolates between a and b by t.
+func Lerp[V Vector[V, S], S Scalar](a, b V, t float64) V {
+	switch a := any(a).(type) {
+	case Vec2g[S]:
+		return any(Lerp2(a, any(b).(Vec2g[S]), t)).(V)
+	case Vec3g[S]:
+		return any(Lerp3(a, any(b).(Vec3g[S]), t)).(V)
+	default:
+		return any(Lerp4(a.(Vec4g[S]), any(b).(Vec4g[S]), t)).(V)
+	}
+}
+
+// Clamp returns v with each component clamped between the corresponding
+// components of lo and hi.
+func Clamp[V Vector[V, S], S Scalar](v, lo, hi V) V {
+	return Zip(Zip(v, lo, func(x, l S) S { return max(x, l) }), hi, func(x, h S) S { return min(x, h) })
+}
+
+// Map applies f to each component of v.
+func Map[V Vector[V, S], S Scalar](v V, f func(S) S) V {
+	switch v := any(v).(type) {
+	case Vec2g[S]:
+		return any(Map2(v, f)).(V)
+	case Vec3g[S]:
+		return any(Map3(v, f)).(V)
+	default:
+		return any(Map4(v.(Vec4g[S]), f)).(V)
+	}
+}
+
+// Zip applies f to corresponding components of a and b.
+func Zip[V Vector[V, S], S Scalar](a, b V, f func(S, S) S) V {
+	switch a := any(a).(type) {
+	case Vec2g[S]:
+		return any(Zip2(a, any(b).(Vec2g[S]), f)).(V)
+	case Vec3g[S]:
+		return any(Zip3(a, any(b).(Vec3g[S]), f)).(V)
+	default:
+		return any(Zip4(a.(Vec4g[S]), any(b).(Vec4g[S]), f)).(V)
+	}
+}
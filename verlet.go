@@ -0,0 +1,100 @@
+package vec
+
+// ParticleSystem simulates points connected by distance constraints with
+// Verlet integration, which is enough for ropes, chains and simple cloth.
+// V is Vec2 or Vec3.
+//
+// Each step moves the particles by their implied velocity and gravity,
+// then repeatedly nudges the ends of each constraint toward its rest
+// length. More iterations make constraints stiffer. Pinned particles stay
+// put unless moved by writing Pos directly, as for a rope held by a hand.
+type ParticleSystem[V Vector[V, float64]] struct {
+	Pos    []V    // current positions
+	Prev   []V    // positions at the previous step
+	Pinned []bool // whether each particle is fixed in place
+
+	Constraints []DistanceConstraint
+
+	Gravity V
+	// Damping is the fraction of velocity lost each step, in [0, 1].
+	Damping float64
+}
+
+// DistanceConstraint keeps particles A and B of a ParticleSystem Length
+// apart. Stiffness in (0, 1] is the fraction of the error corrected per
+// iteration; 1 is rigid.
+type DistanceConstraint struct {
+	A, B      int
+	Length    float64
+	Stiffness float64
+}
+
+// AddParticle adds a particle at rest at pos and returns its index.
+func (s *ParticleSystem[V]) AddParticle(pos V) int {
+	s.Pos = append(s.Pos, pos)
+	s.Prev = append(s.Prev, pos)
+	s.Pinned = append(s.Pinned, false)
+	return len(s.Pos) - 1
+}
+
+// Connect adds a rigid constraint keeping particles a and b at their
+// current distance.
+func (s *ParticleSystem[V]) Connect(a, b int) {
+	s.Constraints = append(s.Constraints, DistanceConstraint{a, b, Distance(s.Pos[a], s.Pos[b]), 1})
+}
+
+// Pin fixes particle i at pos.
+func (s *ParticleSystem[V]) Pin(i int, pos V) {
+	s.Pos[i], s.Prev[i], s.Pinned[i] = pos, pos, true
+}
+
+// Unpin releases particle i, which starts at rest.
+func (s *ParticleSystem[V]) Unpin(i int) {
+	s.Prev[i], s.Pinned[i] = s.Pos[i], false
+}
+
+// Step advances the simulation by dt, solving the constraints iterations
+// times. dt should stay the same from step to step, since velocities are
+// implied by the distance moved in the previous step.
+func (s *ParticleSystem[V]) Step(dt float64, iterations int) {
+	accel := s.Gravity.Scale(dt * dt)
+	for i, p := range s.Pos {
+		if s.Pinned[i] {
+			s.Prev[i] = p
+			continue
+		}
+		vel := p.Sub(s.Prev[i]).Scale(1 - s.Damping)
+		s.Prev[i] = p
+		s.Pos[i] = p.Add(vel).Add(accel)
+	}
+
+	for range iterations {
+		for _, c := range s.Constraints {
+			s.satisfy(c)
+		}
+	}
+}
+
+// satisfy moves the ends of c toward its rest length, splitting the
+// correction between them unless one is pinned.
+func (s *ParticleSystem[V]) satisfy(c DistanceConstraint) {
+	pa, pb := s.Pos[c.A], s.Pos[c.B]
+	d := pb.Sub(pa)
+	l := Len(d)
+	if l == 0 {
+		return
+	}
+	var wa, wb float64
+	if !s.Pinned[c.A] {
+		wa = 1
+	}
+	if !s.Pinned[c.B] {
+		wb = 1
+	}
+	if wa+wb == 0 {
+		return
+	}
+	corr := d.Scale((l - c.Length) / l * c.Stiffness / (wa + wb))
+	s.Pos[c.A] = pa.Add(corr.Scale(wa))
+	s.Pos[c.B] = pb.Sub(corr.Scale(wb))
+}
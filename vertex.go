@@ -0,0 +1,187 @@
+package vec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// VertexFormat is the encoding of one vertex attribute, named after the
+// WebGPU vertex formats.
+type VertexFormat int
+
+const (
+	Float32x2 VertexFormat = iota
+	Float32x3
+	Float32x4
+	Float16x2
+	Float16x4
+	Unorm8x4
+	Snorm8x4
+	Unorm16x2
+	Snorm16x2
+	Unorm10_10_10_2
+)
+
+// Components returns the number of vector components f encodes.
+func (f VertexFormat) Components() int {
+	switch f {
+	case Float32x3:
+		return 3
+	case Float32x4, Float16x4, Unorm8x4, Snorm8x4, Unorm10_10_10_2:
+		return 4
+	}
+	return 2
+}
+
+// Size returns the number of bytes f occupies.
+func (f VertexFormat) Size() int {
+	switch f {
+	case Float32x2, Float16x4:
+		return 8
+	case Float32x3:
+		return 12
+	case Float32x4:
+		return 16
+	}
+	return 4
+}
+
+// VertexAttrib is an attribute at a byte offset within each vertex.
+type VertexAttrib struct {
+	Format VertexFormat
+	Offset int
+}
+
+// VertexLayout describes interleaved vertices of Stride bytes each.
+type VertexLayout struct {
+	Attribs []VertexAttrib
+	Stride  int
+}
+
+// PackedLayout returns the layout holding formats back to back in order.
+// Every format is a multiple of 4 bytes, so all attributes stay aligned.
+func PackedLayout(formats ...VertexFormat) VertexLayout {
+	var l VertexLayout
+	for _, f := range formats {
+		l.Attribs = append(l.Attribs, VertexAttrib{f, l.Stride})
+		l.Stride += f.Size()
+	}
+	return l
+}
+
+// Interleave encodes the attribute streams data into interleaved vertices.
+// data holds one slice per attribute in layout order, each a []Vec2, []Vec3
+// or []Vec4 (or a float32 instantiation) whose dimension matches the
+// attribute's format, and all of the same length. Bytes not covered by an
+// attribute are zero.
+func (l VertexLayout) Interleave(data ...any) ([]byte, error) {
+	if len(data) != len(l.Attribs) {
+		return nil, fmt.Errorf("vec: %d attribute streams for %d attributes", len(data), len(l.Attribs))
+	}
+	for i, a := range l.Attribs {
+		if a.Offset < 0 || a.Offset+a.Format.Size() > l.Stride {
+			return nil, fmt.Errorf("vec: attribute %d at offset %d does not fit in stride %d", i, a.Offset, l.Stride)
+		}
+	}
+	streams := make([][]Vec4, len(data))
+	n := -1
+	for i, d := range data {
+		s, dim, ok := vertexStream(d)
+		if !ok {
+			return nil, fmt.Errorf("vec: unsupported attribute stream type %T", d)
+		}
+		if f := l.Attribs[i].Format; dim != f.Components() {
+			return nil, fmt.Errorf("vec: attribute %d has %d components, format needs %d", i, dim, f.Components())
+		}
+		if n >= 0 && len(s) != n {
+			return nil, fmt.Errorf("vec: attribute %d has %d vertices, want %d", i, len(s), n)
+		}
+		streams[i], n = s, len(s)
+	}
+
+	buf := make([]byte, max(n, 0)*l.Stride)
+	for v := range max(n, 0) {
+		b := buf[v*l.Stride:]
+		for i, a := range l.Attribs {
+			putVertexAttrib(b[a.Offset:], a.Format, streams[i][v])
+		}
+	}
+	return buf, nil
+}
+
+// InterleaveFloat32 is like Interleave but returns the vertices as float32s,
+// for APIs that take vertex data that way. All attributes must use the
+// Float32 formats and the stride must be a multiple of 4.
+func (l VertexLayout) InterleaveFloat32(data ...any) ([]float32, error) {
+	for i, a := range l.Attribs {
+		if a.Format > Float32x4 || a.Offset%4 != 0 {
+			return nil, fmt.Errorf("vec: attribute %d is not an aligned float32 format", i)
+		}
+	}
+	if l.Stride%4 != 0 {
+		return nil, fmt.Errorf("vec: stride %d is not a multiple of 4", l.Stride)
+	}
+	buf, err := l.Interleave(data...)
+	if err != nil {
+		return nil, err
+	}
+	fs := make([]float32, len(buf)/4)
+	for i := range fs {
+		fs[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:]))
+	}
+	return fs, nil
+}
+
+// vertexStream returns d as a []Vec4 and the dimension of its vectors.
+func vertexStream(d any) (s []Vec4, dim int, ok bool) {
+	switch d := d.(type) {
+	case []Vec2:
+		return widen(d, func(v Vec2) Vec4 { return Vec4{v.X, v.Y, 0, 0} }), 2, true
+	case []Vec3:
+		return widen(d, func(v Vec3) Vec4 { return v.Vec4(0) }), 3, true
+	case []Vec4:
+		return d, 4, true
+	case []Vec2g[float32]:
+		return widen(d, func(v Vec2g[float32]) Vec4 { return Vec4{float64(v.X), float64(v.Y), 0, 0} }), 2, true
+	case []Vec3g[float32]:
+		return widen(d, func(v Vec3g[float32]) Vec4 { return As3[float64](v).Vec4(0) }), 3, true
+	case []Vec4g[float32]:
+		return widen(d, As4[float64, float32, Vec4g[float32]]), 4, true
+	}
+	return nil, 0, false
+}
+
+func widen[V any](vs []V, f func(V) Vec4) []Vec4 {
+	s := make([]Vec4, len(vs))
+	for i, v := range vs {
+		s[i] = f(v)
+	}
+	return s
+}
+
+// putVertexAttrib writes v encoded as f to the start of b.
+func putVertexAttrib(b []byte, f VertexFormat, v Vec4) {
+	le := binary.LittleEndian
+	cs := [4]float64{v.X, v.Y, v.Z, v.W}
+	switch f {
+	case Float32x2, Float32x3, Float32x4:
+		for i := range f.Components() {
+			le.PutUint32(b[4*i:], math.Float32bits(float32(cs[i])))
+		}
+	case Float16x2, Float16x4:
+		for i := range f.Components() {
+			le.PutUint16(b[2*i:], FloatToHalf(cs[i]))
+		}
+	case Unorm8x4:
+		le.PutUint32(b, PackUnorm4x8(v))
+	case Snorm8x4:
+		le.PutUint32(b, PackSnorm4x8(v))
+	case Unorm16x2:
+		le.PutUint32(b, PackUnorm2x16(Vec2{v.X, v.Y}))
+	case Snorm16x2:
+		le.PutUint32(b, PackSnorm2x16(Vec2{v.X, v.Y}))
+	case Unorm10_10_10_2:
+		le.PutUint32(b, PackUnorm1010102(v))
+	}
+}
@@ -0,0 +1,52 @@
+package vec
+
+// The functions below convert points between three 2D spaces:
+//
+//   - pixel coordinates within a viewport rectangle,
+//   - normalized device coordinates (NDC), from -1 to 1 across the viewport,
+//   - texture coordinates (UV), from 0 to 1 across the viewport.
+//
+// Backends disagree on which way Y points in each space. With flipY false,
+// Y grows the same way in both spaces, from viewport.Min.Y; with flipY
+// true, it is reversed. Screens with Y growing downward, as Project
+// assumes, and OpenGL NDC with Y up need flipY true.
+
+// PixelToNDC converts the pixel position p within viewport to NDC.
+func PixelToNDC(p Vec2, viewport Rect, flipY bool) Vec2 {
+	return UVToNDC(PixelToUV(p, viewport, false), flipY)
+}
+
+// NDCToPixel converts ndc to a pixel position within viewport.
+func NDCToPixel(ndc Vec2, viewport Rect, flipY bool) Vec2 {
+	return UVToPixel(NDCToUV(ndc, flipY), viewport, false)
+}
+
+// PixelToUV converts the pixel position p within viewport to UV.
+func PixelToUV(p Vec2, viewport Rect, flipY bool) Vec2 {
+	uv := p.Sub(viewport.Min).Div(viewport.Size())
+	return flipUV(uv, flipY)
+}
+
+// UVToPixel converts uv to a pixel position within viewport.
+func UVToPixel(uv Vec2, viewport Rect, flipY bool) Vec2 {
+	return viewport.Min.Add(flipUV(uv, flipY).Mul(viewport.Size()))
+}
+
+// NDCToUV converts ndc to UV, the same rectangle viewed by texture lookups.
+func NDCToUV(ndc Vec2, flipY bool) Vec2 {
+	return flipUV(Vec2{(ndc.X + 1) / 2, (ndc.Y + 1) / 2}, flipY)
+}
+
+// UVToNDC converts uv to NDC.
+func UVToNDC(uv Vec2, flipY bool) Vec2 {
+	uv = flipUV(uv, flipY)
+	return Vec2{2*uv.X - 1, 2*uv.Y - 1}
+}
+
+// flipUV returns uv with V reversed if flip is true.
+func flipUV(uv Vec2, flip bool) Vec2 {
+	if flip {
+		uv.Y = 1 - uv.Y
+	}
+	return uv
+}
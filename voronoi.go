@@ -0,0 +1,61 @@
+package vec
+
+// VoronoiCells returns the Voronoi cell of each of points within bounds:
+// the convex region of bounds closer to that point than to any other.
+// Each cell is found by clipping bounds against the bisectors with every
+// other point, which takes quadratic time but needs no triangulation.
+// A point outside bounds may get an empty cell.
+func VoronoiCells(points []Vec2, bounds Rect) []Polygon {
+	box := Polygon{bounds.Min, {bounds.Max.X, bounds.Min.Y}, bounds.Max, {bounds.Min.X, bounds.Max.Y}}
+	cells := make([]Polygon, len(points))
+	for i, p := range points {
+		cell := box
+		for j, q := range points {
+			if j == i || q == p {
+				continue
+			}
+			// Keep the side of the bisector of p and q nearer to p.
+			n := q.Sub(p)
+			cell = clipHalfPlane(cell, n, (LenSq2(q)-LenSq2(p))/2)
+			if len(cell) == 0 {
+				break
+			}
+		}
+		cells[i] = cell
+	}
+	return cells
+}
+
+// LloydRelax returns points after iterations rounds of Lloyd's algorithm,
+// each moving every point to the centroid of its Voronoi cell within
+// bounds. The points spread out toward an even spacing while keeping
+// their rough arrangement, as for scattering sites of a procedural map.
+// points is not modified.
+func LloydRelax(points []Vec2, bounds Rect, iterations int) []Vec2 {
+	ps := append([]Vec2(nil), points...)
+	for range iterations {
+		for i, cell := range VoronoiCells(ps, bounds) {
+			if len(cell) > 0 {
+				ps[i] = cell.Centroid()
+			}
+		}
+	}
+	return ps
+}
+
+// clipHalfPlane returns the part of the convex polygon poly where
+// Dot2(p, n) <= c.
+func clipHalfPlane(poly Polygon, n Vec2, c float64) Polygon {
+	var out Polygon
+	for i, a := range poly {
+		b := poly[(i+1)%len(poly)]
+		da, db := Dot2(a, n)-c, Dot2(b, n)-c
+		if da <= 0 {
+			out = append(out, a)
+		}
+		if (da < 0 && db > 0) || (da > 0 && db < 0) {
+			out = append(out, Lerp2(a, b, da/(da-db)))
+		}
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+package vec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The functions below format and parse 2D geometry as Well-Known Text, as
+// used by PostGIS and other GIS tools. As with GeoJSON, X comes first and
+// is read as the longitude of geographic data, and polygon rings are
+// written closed and parsed back without the repeated vertex.
+
+// FormatWKTPoint returns p as a WKT POINT.
+func FormatWKTPoint(p Vec2) string {
+	return string(appendWKTPoints([]byte("POINT ("), []Vec2{p}, false)) + ")"
+}
+
+// FormatWKTLineString returns path as a WKT LINESTRING.
+func FormatWKTLineString(path []Vec2) string {
+	if len(path) == 0 {
+		return "LINESTRING EMPTY"
+	}
+	return string(appendWKTPoints([]byte("LINESTRING ("), path, false)) + ")"
+}
+
+// FormatWKTPolygon returns the polygon with the given rings, the exterior
+// boundary followed by any holes, as a WKT POLYGON.
+func FormatWKTPolygon(rings []Polygon) string {
+	if len(rings) == 0 {
+		return "POLYGON EMPTY"
+	}
+	b := []byte("POLYGON (")
+	for i, r := range rings {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = append(appendWKTPoints(append(b, '('), r, true), ')')
+	}
+	return string(b) + ")"
+}
+
+// ParseWKTPoint parses a WKT POINT. The keyword is matched
+// case-insensitively.
+func ParseWKTPoint(s string) (Vec2, error) {
+	body, err := wktBody(s, "POINT")
+	if err != nil {
+		return Vec2{}, err
+	}
+	if body == "" {
+		return Vec2{}, fmt.Errorf("vec: parsing WKT %q: empty point", s)
+	}
+	ps, err := parseWKTPoints(s, body, false)
+	if err != nil {
+		return Vec2{}, err
+	}
+	if len(ps) != 1 {
+		return Vec2{}, fmt.Errorf("vec: parsing WKT %q: expected 1 point, got %d", s, len(ps))
+	}
+	return ps[0], nil
+}
+
+// ParseWKTLineString parses a WKT LINESTRING. It returns nil for
+// LINESTRING EMPTY.
+func ParseWKTLineString(s string) ([]Vec2, error) {
+	body, err := wktBody(s, "LINESTRING")
+	if err != nil || body == "" {
+		return nil, err
+	}
+	return parseWKTPoints(s, body, false)
+}
+
+// ParseWKTPolygon parses a WKT POLYGON into its rings, the exterior
+// boundary first. It returns nil for POLYGON EMPTY.
+func ParseWKTPolygon(s string) ([]Polygon, error) {
+	body, err := wktBody(s, "POLYGON")
+	if err != nil || body == "" {
+		return nil, err
+	}
+	var rings []Polygon
+	for rest := body; ; {
+		rest = strings.TrimSpace(rest)
+		end := strings.IndexByte(rest, ')')
+		if !strings.HasPrefix(rest, "(") || end < 0 {
+			return nil, fmt.Errorf("vec: parsing WKT %q: malformed ring", s)
+		}
+		ring, err := parseWKTPoints(s, rest[1:end], true)
+		if err != nil {
+			return nil, err
+		}
+		rings = append(rings, ring)
+		rest = strings.TrimSpace(rest[end+1:])
+		if rest == "" {
+			return rings, nil
+		}
+		if rest[0] != ',' {
+			return nil, fmt.Errorf("vec: parsing WKT %q: expected ',' between rings", s)
+		}
+		rest = rest[1:]
+	}
+}
+
+// appendWKTPoints appends the points of ps separated by commas, repeating
+// the first at the end if closed.
+func appendWKTPoints(b []byte, ps []Vec2, closed bool) []byte {
+	if closed && len(ps) > 0 {
+		ps = append(ps[:len(ps):len(ps)], ps[0])
+	}
+	for i, p := range ps {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = strconv.AppendFloat(b, p.X, 'f', -1, 64)
+		b = append(b, ' ')
+		b = strconv.AppendFloat(b, p.Y, 'f', -1, 64)
+	}
+	return b
+}
+
+// wktBody checks that s is the geometry tag and returns the text between
+// its outer parentheses, or "" if it is EMPTY.
+func wktBody(s, tag string) (string, error) {
+	t := strings.TrimSpace(s)
+	if len(t) < len(tag) || !strings.EqualFold(t[:len(tag)], tag) {
+		return "", fmt.Errorf("vec: parsing WKT %q: expected %s", s, tag)
+	}
+	t = strings.TrimSpace(t[len(tag):])
+	if strings.EqualFold(t, "EMPTY") {
+		return "", nil
+	}
+	if len(t) < 2 || t[0] != '(' || t[len(t)-1] != ')' {
+		return "", fmt.Errorf("vec: parsing WKT %q: expected parenthesized coordinates", s)
+	}
+	return t[1 : len(t)-1], nil
+}
+
+// parseWKTPoints parses the comma-separated points of body, which is part
+// of s, dropping the repeated last point of a closed ring.
+func parseWKTPoints(s, body string, closed bool) ([]Vec2, error) {
+	var ps []Vec2
+	for _, f := range strings.Split(body, ",") {
+		c := strings.Fields(f)
+		if len(c) != 2 {
+			return nil, fmt.Errorf("vec: parsing WKT %q: expected 2 coordinates, got %d", s, len(c))
+		}
+		var p [2]float64
+		for i := range p {
+			x, err := strconv.ParseFloat(c[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("vec: parsing WKT %q: %w", s, err)
+			}
+			p[i] = x
+		}
+		ps = append(ps, Vec2{p[0], p[1]})
+	}
+	if closed && len(ps) > 1 && ps[0] == ps[len(ps)-1] {
+		ps = ps[:len(ps)-1]
+	}
+	return ps, nil
+}
@@ -0,0 +1,92 @@
+package vec
+
+import "math"
+
+// Wrap2 wraps each component of v into [min, max) by adding or subtracting
+// multiples of the range width, as for positions in a toroidal world.
+func Wrap2[V Vec2like[S], S Scalar](v, min, max V) V {
+	va, lo, hi := Vec2g[S](v), Vec2g[S](min), Vec2g[S](max)
+	return V(Vec2g[S]{wrap(va.X, lo.X, hi.X), wrap(va.Y, lo.Y, hi.Y)})
+}
+
+// Wrap3 wraps each component of v into [min, max) by adding or subtracting
+// multiples of the range width, as for positions in a toroidal world.
+func Wrap3[V Vec3like[S], S Scalar](v, min, max V) V {
+	va, lo, hi := Vec3g[S](v), Vec3g[S](min), Vec3g[S](max)
+	return V(Vec3g[S]{wrap(va.X, lo.X, hi.X), wrap(va.Y, lo.Y, hi.Y), wrap(va.Z, lo.Z, hi.Z)})
+}
+
+// MirrorRepeat2 folds each component of v back and forth into the range
+// from min to max, like mirrored-repeat texture addressing: the value runs
+// up to max, then back down to min, and so on. For integer components the
+// range excludes max and the end values repeat, as texel indices do:
+// 0, 1, 2, 2, 1, 0, 0, 1 for the range 0..3.
+func MirrorRepeat2[V Vec2like[S], S Scalar](v, min, max V) V {
+	va, lo, hi := Vec2g[S](v), Vec2g[S](min), Vec2g[S](max)
+	return V(Vec2g[S]{mirror(va.X, lo.X, hi.X), mirror(va.Y, lo.Y, hi.Y)})
+}
+
+// MirrorRepeat3 folds each component of v back and forth into the range
+// from min to max. See MirrorRepeat2.
+func MirrorRepeat3[V Vec3like[S], S Scalar](v, min, max V) V {
+	va, lo, hi := Vec3g[S](v), Vec3g[S](min), Vec3g[S](max)
+	return V(Vec3g[S]{mirror(va.X, lo.X, hi.X), mirror(va.Y, lo.Y, hi.Y), mirror(va.Z, lo.Z, hi.Z)})
+}
+
+// positiveMod returns x modulo m in [0, m) for m > 0.
+func positiveMod[S Scalar](x, m S) S {
+	if isFloat[S]() {
+		r := S(math.Mod(float64(x), float64(m)))
+		if r < 0 {
+			r += m
+		}
+		// r+m rounds up to m for tiny negative r.
+		if r >= m {
+			r = 0
+		}
+		return r
+	}
+	if !isSigned[S]() {
+		return S(uint64(x) % uint64(m))
+	}
+	r := int64(x) % int64(m)
+	if r < 0 {
+		r += int64(m)
+	}
+	return S(r)
+}
+
+// offsetMod returns x-lo modulo m in [0, m), without the unsigned
+// underflow of x-lo when x < lo.
+func offsetMod[S Scalar](x, lo, m S) S {
+	if x >= lo || isSigned[S]() || isFloat[S]() {
+		return positiveMod(x-lo, m)
+	}
+	if d := positiveMod(lo-x, m); d != 0 {
+		return m - d
+	}
+	return 0
+}
+
+func wrap[S Scalar](x, lo, hi S) S {
+	if hi <= lo {
+		return lo
+	}
+	return lo + offsetMod(x, lo, hi-lo)
+}
+
+func mirror[S Scalar](x, lo, hi S) S {
+	if hi <= lo {
+		return lo
+	}
+	w := hi - lo
+	t := offsetMod(x, lo, 2*w)
+	if t >= w {
+		if isFloat[S]() {
+			t = 2*w - t
+		} else {
+			t = 2*w - 1 - t
+		}
+	}
+	return lo + t
+}